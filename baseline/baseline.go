@@ -0,0 +1,203 @@
+package baseline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Violation identifies a checker violation independently of its line
+// number so that it survives unrelated changes of the file.
+type Violation struct {
+
+	// Slug is the slug of the checker that registered the violation.
+	Slug string
+
+	// Path is the path of the file of the violation.
+	Path string
+
+	// Line is the content of the line where the violation starts.
+	Line string
+
+	// Message is the violation message.
+	Message string
+}
+
+// Baseline is a set of known violations which are not reported. A baseline
+// is stored in a file and the paths of its violations are relative to the
+// directory of that file.
+type Baseline struct {
+	path    string
+	entries map[string]*entry
+	matched map[string]int
+}
+
+// document is the structure of a baseline file.
+type document struct {
+
+	// Violations contains the recorded violations sorted by path.
+	Violations []*entry `json:"violations"`
+}
+
+type entry struct {
+
+	// Fingerprint identifies the violation.
+	Fingerprint string `json:"fingerprint"`
+
+	// Slug is the slug of the checker that registered the violation.
+	Slug string `json:"slug"`
+
+	// Path is the slash-separated path of the file of the violation.
+	Path string `json:"path"`
+
+	// Message is the message of the first recorded violation.
+	Message string `json:"message"`
+
+	// Count is the number of violations with the same fingerprint.
+	Count int `json:"count"`
+}
+
+// New creates an empty baseline which is stored at `path`.
+func New(path string) *Baseline {
+	return &Baseline{
+		path:    path,
+		entries: map[string]*entry{},
+		matched: map[string]int{},
+	}
+}
+
+// Load reads the baseline stored at `path`.
+func Load(path string) (*Baseline, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read baseline file: %s", path)
+	}
+
+	var doc document
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to parse baseline file: %s", path)
+	}
+
+	b := New(path)
+	for _, e := range doc.Violations {
+		if existing, ok := b.entries[e.Fingerprint]; ok {
+			existing.Count += e.Count
+			continue
+		}
+
+		b.entries[e.Fingerprint] = e
+	}
+
+	return b, nil
+}
+
+// Add records `violation` in the baseline.
+func (b *Baseline) Add(violation Violation) {
+	path := b.relativePath(violation.Path)
+	fingerprint := Fingerprint(violation.Slug, path, violation.Line)
+
+	if e, ok := b.entries[fingerprint]; ok {
+		e.Count++
+		return
+	}
+
+	b.entries[fingerprint] = &entry{
+		Fingerprint: fingerprint,
+		Slug:        violation.Slug,
+		Path:        path,
+		Message:     violation.Message,
+		Count:       1,
+	}
+}
+
+// Match reports whether `violation` is recorded in the baseline. Every
+// recorded violation matches only as many violations as were recorded.
+func (b *Baseline) Match(violation Violation) bool {
+	path := b.relativePath(violation.Path)
+	fingerprint := Fingerprint(violation.Slug, path, violation.Line)
+
+	e, ok := b.entries[fingerprint]
+	if !ok || b.matched[fingerprint] >= e.Count {
+		return false
+	}
+
+	b.matched[fingerprint]++
+	return true
+}
+
+// Len returns the number of violations recorded in the baseline.
+func (b *Baseline) Len() int {
+	count := 0
+	for _, e := range b.entries {
+		count += e.Count
+	}
+
+	return count
+}
+
+// Save writes the baseline to its file.
+func (b *Baseline) Save() error {
+	doc := document{
+		Violations: []*entry{},
+	}
+	for _, e := range b.entries {
+		doc.Violations = append(doc.Violations, e)
+	}
+
+	sort.Slice(doc.Violations, func(i, j int) bool {
+		a, b := doc.Violations[i], doc.Violations[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		if a.Slug != b.Slug {
+			return a.Slug < b.Slug
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(b.path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write baseline file: %s", b.path)
+	}
+
+	return nil
+}
+
+// relativePath returns `path` relative to the directory of the baseline
+// file.
+func (b *Baseline) relativePath(path string) string {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	absDir, err := filepath.Abs(filepath.Dir(b.path))
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	rel, err := filepath.Rel(absDir, absPath)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// Fingerprint returns the fingerprint of a violation of the checker `slug`
+// in the file at `path` on a line with content `line`. Whitespace in `line`
+// is normalized so that reindenting the code keeps the fingerprint.
+func Fingerprint(slug, path, line string) string {
+	normalized := strings.Join(strings.Fields(line), " ")
+	hash := sha256.Sum256([]byte(slug + "\x00" + path + "\x00" + normalized))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,153 @@
+package baseline_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/s2gatev/lingo/baseline"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBaselineMatch(t *testing.T) {
+	type test struct {
+		description string
+		recorded    []Violation
+		violation   Violation
+		expected    bool
+	}
+
+	violation := Violation{
+		Slug:    "multi_word_ident_name",
+		Path:    "foo/bar.go",
+		Line:    "var foo_bar int",
+		Message: "name 'foo_bar' is not valid",
+	}
+
+	tests := []test{
+		{
+			description: "recorded violation",
+			recorded:    []Violation{violation},
+			violation:   violation,
+			expected:    true,
+		},
+		{
+			description: "empty baseline",
+			violation:   violation,
+			expected:    false,
+		},
+		{
+			description: "reindented line",
+			recorded:    []Violation{violation},
+			violation: Violation{
+				Slug: "multi_word_ident_name",
+				Path: "foo/bar.go",
+				Line: "\t\tvar   foo_bar int",
+			},
+			expected: true,
+		},
+		{
+			description: "changed line",
+			recorded:    []Violation{violation},
+			violation: Violation{
+				Slug: "multi_word_ident_name",
+				Path: "foo/bar.go",
+				Line: "var foo_baz int",
+			},
+			expected: false,
+		},
+		{
+			description: "other checker",
+			recorded:    []Violation{violation},
+			violation: Violation{
+				Slug: "line_length",
+				Path: "foo/bar.go",
+				Line: "var foo_bar int",
+			},
+			expected: false,
+		},
+		{
+			description: "other file",
+			recorded:    []Violation{violation},
+			violation: Violation{
+				Slug: "multi_word_ident_name",
+				Path: "foo/baz.go",
+				Line: "var foo_bar int",
+			},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			b := New("lingo-baseline.json")
+			for _, violation := range test.recorded {
+				b.Add(violation)
+			}
+
+			assert.Equal(t, test.expected, b.Match(test.violation))
+		})
+	}
+}
+
+func TestBaselineMatchCount(t *testing.T) {
+	violation := Violation{
+		Slug: "line_length",
+		Path: "foo.go",
+		Line: "// a long line",
+	}
+
+	b := New("lingo-baseline.json")
+	b.Add(violation)
+	b.Add(violation)
+
+	assert.Equal(t, 2, b.Len())
+	assert.True(t, b.Match(violation))
+	assert.True(t, b.Match(violation))
+	assert.False(t, b.Match(violation))
+}
+
+func TestBaselineSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "lingo")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "lingo-baseline.json")
+	violation := Violation{
+		Slug:    "multi_word_ident_name",
+		Path:    filepath.Join(dir, "foo", "bar.go"),
+		Line:    "var foo_bar int",
+		Message: "name 'foo_bar' is not valid",
+	}
+
+	b := New(path)
+	b.Add(violation)
+	assert.NoError(t, b.Save())
+
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, `{
+  "violations": [
+    {
+      "fingerprint": "`+Fingerprint("multi_word_ident_name", "foo/bar.go", "var foo_bar int")+`",
+      "slug": "multi_word_ident_name",
+      "path": "foo/bar.go",
+      "message": "name 'foo_bar' is not valid",
+      "count": 1
+    }
+  ]
+}
+`, string(data))
+
+	loaded, err := Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, loaded.Len())
+	assert.True(t, loaded.Match(violation))
+}
+
+func TestLoadMissingBaseline(t *testing.T) {
+	_, err := Load(filepath.Join("testdata", "missing.json"))
+	assert.Error(t, err)
+}
@@ -7,16 +7,22 @@ import (
 )
 
 func init() {
-	must(Register("bad_range_reference", NewBadRangeReferenceChecker))
+	must(Register(badRangeReferenceSlug, NewBadRangeReferenceChecker))
 }
 
+const badRangeReferenceSlug = "bad_range_reference"
+
 // BadRangeReferenceChecker checks that vars declared in a range statement
 // are not used by reference inside the body of the loop.
 type BadRangeReferenceChecker struct{}
 
 // NewBadRangeReferenceChecker constructs a new BadRangeReferenceChecker.
-func NewBadRangeReferenceChecker(configData interface{}) NodeChecker {
-	return &BadRangeReferenceChecker{}
+func NewBadRangeReferenceChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(badRangeReferenceSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &BadRangeReferenceChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -82,14 +88,14 @@ func (c *BadRangeReferenceChecker) checkReference(
 		return
 	}
 
-	ident := expr.(*ast.Ident)
-	if ident.Obj.Name == "_" {
+	ident, ok := expr.(*ast.Ident)
+	if !ok || ident.Name == "_" {
 		return
 	}
 
 	ast.Walk(&badReferenceVisitor{
 		report: report,
-		name:   ident.Obj.Name,
+		name:   ident.Name,
 	}, body)
 }
 
@@ -160,7 +166,7 @@ func (v *badReferenceVisitor) visitAssignStmt(stmt *ast.AssignStmt) {
 			continue
 		}
 
-		if ident.Obj.Name == v.name {
+		if ident.Name == v.name {
 			v.overridden = true
 			break
 		}
@@ -173,13 +179,15 @@ func (v *badReferenceVisitor) checkUnaryExpr(expr *ast.UnaryExpr) {
 		return
 	}
 
-	if ident.Obj.Name != v.name {
+	if ident.Name != v.name {
 		return
 	}
 
 	if expr.Op == token.AND {
 		v.report.Errors = append(v.report.Errors, Error{
+			Slug:    badRangeReferenceSlug,
 			Pos:     expr.Pos(),
+			End:     expr.End(),
 			Message: fmt.Sprintf("bad reference of range var: %s", v.name),
 		})
 	}
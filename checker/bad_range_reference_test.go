@@ -43,7 +43,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     97,
+						End:     101,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -63,7 +65,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     100,
+						End:     104,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -83,7 +87,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     102,
+						End:     106,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -119,7 +125,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     100,
+						End:     104,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -173,7 +181,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     153,
+						End:     157,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -197,7 +207,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     156,
+						End:     160,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -218,7 +230,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     95,
+						End:     99,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -240,7 +254,9 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "bad_range_reference",
 						Pos:     129,
+						End:     133,
 						Message: "bad reference of range var: foo",
 					},
 				},
@@ -251,7 +267,7 @@ func TestBadRangeReferenceChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewBadRangeReferenceChecker(nil))
+			checker.Register(mustNew(NewBadRangeReferenceChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -1,19 +1,96 @@
 package checker
 
 import (
+	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
 	"reflect"
+	"strings"
+
+	"github.com/s2gatev/lingo/file"
 )
 
+// Severity indicates how serious a checker violation is.
+type Severity int
+
+const (
+	// SeverityError indicates a violation that must be fixed.
+	SeverityError Severity = iota
+
+	// SeverityWarning indicates a violation that should be fixed.
+	SeverityWarning
+
+	// SeverityInfo indicates a violation that is reported for information
+	// only.
+	SeverityInfo
+)
+
+// String returns the name of the severity.
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	default:
+		return "unknown"
+	}
+}
+
+// AtLeast reports whether the severity is at least as serious as `other`.
+func (s Severity) AtLeast(other Severity) bool {
+	return s <= other
+}
+
+// ParseSeverity returns the severity with `name`.
+func ParseSeverity(name string) (Severity, error) {
+	for _, severity := range []Severity{SeverityError, SeverityWarning, SeverityInfo} {
+		if severity.String() == name {
+			return severity, nil
+		}
+	}
+
+	return SeverityError, fmt.Errorf(
+		"invalid severity %s, expected error, warning or info", name)
+}
+
 // Error is a description of a checker violation.
 type Error struct {
 
+	// Slug is the slug of the checker that registered the error.
+	Slug string
+
+	// Severity is the severity of the error.
+	Severity Severity
+
 	// Pos is the position in a file where the error occurred.
 	Pos token.Pos
 
+	// End is the position in a file where the erroneous code ends.
+	End token.Pos
+
 	// Message is the error message.
 	Message string
+
+	// Edits contains the text edits which fix the error, if any.
+	Edits []Edit
+}
+
+// Edit is a replacement of text in a file.
+type Edit struct {
+
+	// Pos is the position in a file where the replaced text starts.
+	Pos token.Pos
+
+	// End is the position in a file where the replaced text ends. If End is
+	// equal to Pos the edit is an insertion.
+	End token.Pos
+
+	// NewText is the text which replaces the text between Pos and End.
+	NewText string
 }
 
 // Report collects the results of a run of some checkers.
@@ -21,6 +98,10 @@ type Report struct {
 
 	// Errors contains all violations registered by the checkers.
 	Errors []Error
+
+	// Suppressed contains the violations which were registered by the
+	// checkers but suppressed by comments.
+	Suppressed []Error
 }
 
 // Example shows how to adhere and not adere to a rule.
@@ -33,6 +114,36 @@ type Example struct {
 	Bad string
 }
 
+// Dedent removes the blank lines around `code` and the indentation common to
+// all of its lines.
+func Dedent(code string) string {
+	lines := strings.Split(strings.Trim(code, "\n"), "\n")
+
+	indent := ""
+	indented := false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		lineIndent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if !indented {
+			indent = lineIndent
+			indented = true
+		}
+
+		for !strings.HasPrefix(lineIndent, indent) {
+			indent = indent[:len(indent)-1]
+		}
+	}
+
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(strings.TrimPrefix(line, indent), " \t")
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
 // NodeChecker checks ast.Node values for violations.
 type NodeChecker interface {
 
@@ -53,25 +164,97 @@ type NodeChecker interface {
 	Check(node ast.Node, content string, report *Report)
 }
 
+// TypeInfo holds the type information of the package a checked file
+// belongs to.
+type TypeInfo struct {
+
+	// Package is the type-checked package.
+	Package *types.Package
+
+	// Info contains the type information of the files of the package.
+	Info *types.Info
+}
+
+// TypedNodeChecker is a NodeChecker that can use type information to
+// check ast.Node values for violations.
+type TypedNodeChecker interface {
+	NodeChecker
+
+	// CheckTyped checks `node` using the type information in `info`
+	// and registers violations in `report`.
+	CheckTyped(node ast.Node, info *TypeInfo, report *Report)
+}
+
+// PackageChecker is a NodeChecker that checks all files of a package
+// together for violations.
+type PackageChecker interface {
+	NodeChecker
+
+	// CheckPackage checks `files`, which belong to the same package, and
+	// registers violations in `report`.
+	CheckPackage(files []*ast.File, report *Report)
+}
+
+// ConfiguredChecker is a NodeChecker with configuration options.
+type ConfiguredChecker interface {
+	NodeChecker
+
+	// Config returns the configuration struct of the checker with the values
+	// of the options it checks with.
+	Config() interface{}
+}
+
 // FileChecker checks ast.File values for violations.
 type FileChecker struct {
-	checkers map[string][]NodeChecker
+	checkers        map[string][]NodeChecker
+	packageCheckers []PackageChecker
+	matchers        map[NodeChecker][]file.Matcher
+	severities      map[string]Severity
+	fileSet         *token.FileSet
+	info            *TypeInfo
+	requireReason   bool
 }
 
 // NewFileChecker creates a new FileChecker.
 func NewFileChecker() *FileChecker {
 	return &FileChecker{
-		checkers: map[string][]NodeChecker{},
+		checkers:   map[string][]NodeChecker{},
+		matchers:   map[NodeChecker][]file.Matcher{},
+		severities: map[string]Severity{},
 	}
 }
 
 // Register registers all `checkers`.
 func (c *FileChecker) Register(checkers ...NodeChecker) {
 	for _, checker := range checkers {
+		if packageChecker, ok := checker.(PackageChecker); ok {
+			c.packageCheckers = append(c.packageCheckers, packageChecker)
+		}
+
 		checker.Register(c)
 	}
 }
 
+// RegisterMatching registers `checker` to check only the files whose paths
+// are accepted by all `matchers`. The paths of checked files are resolved in
+// the file set set with SetFileSet.
+func (c *FileChecker) RegisterMatching(checker NodeChecker, matchers ...file.Matcher) {
+	c.Register(checker)
+	c.matchers[checker] = matchers
+}
+
+// SetSeverity overrides the severity of the errors registered by the checker
+// referenced by `slug`.
+func (c *FileChecker) SetSeverity(slug string, severity Severity) {
+	c.severities[slug] = severity
+}
+
+// SetFileSet sets the file set in which the paths of checked files are
+// resolved.
+func (c *FileChecker) SetFileSet(fileSet *token.FileSet) {
+	c.fileSet = fileSet
+}
+
 // On registers `checker` for specific node type inferred from the type
 // of `nodeType`.
 func (c *FileChecker) On(nodeType interface{}, checker NodeChecker) {
@@ -81,194 +264,256 @@ func (c *FileChecker) On(nodeType interface{}, checker NodeChecker) {
 		c.checkers[typeName], checker)
 }
 
-// Check checks `file` for violations and registers them in `report`.
-func (c *FileChecker) Check(file *ast.File, content string, report *Report) {
-	c.emit(file, content, report)
-
-	for _, decl := range file.Decls {
-		c.visitDecl(decl, report)
+// WithTypes returns a FileChecker with the same registered checkers that
+// provides the type information in `info` to TypedNodeChecker values.
+func (c *FileChecker) WithTypes(info *TypeInfo) *FileChecker {
+	return &FileChecker{
+		checkers:        c.checkers,
+		packageCheckers: c.packageCheckers,
+		matchers:        c.matchers,
+		severities:      c.severities,
+		fileSet:         c.fileSet,
+		info:            info,
+		requireReason:   c.requireReason,
 	}
 }
 
-func (c *FileChecker) emit(node ast.Node, content string, report *Report) {
-	typeName := reflect.TypeOf(node).String()
+// RequireSuppressionReason makes suppression comments without a reason
+// invalid. Such comments do not suppress violations and are reported.
+func (c *FileChecker) RequireSuppressionReason() {
+	c.requireReason = true
+}
 
-	for _, checker := range c.checkers[typeName] {
-		emittedContent := ""
-		if len(content) > 0 {
-			emittedContent = content[node.Pos()-1 : node.End()-1]
+// HasTypedCheckers reports whether any of the registered checkers is a
+// TypedNodeChecker.
+func (c *FileChecker) HasTypedCheckers() bool {
+	for _, checkers := range c.checkers {
+		for _, checker := range checkers {
+			if _, ok := checker.(TypedNodeChecker); ok {
+				return true
+			}
 		}
-		checker.Check(node, emittedContent, report)
 	}
+
+	return false
 }
 
-func (c *FileChecker) visitDecl(decl ast.Decl, report *Report) {
-	switch decl := decl.(type) {
-	case *ast.GenDecl:
-		c.visitGenDecl(decl, report)
-	case *ast.FuncDecl:
-		c.visitFuncDecl(decl, report)
+// Check checks `file` for violations and registers them in `report`.
+// Every node of `file` is visited in depth-first order.
+//
+// Violations can be suppressed by comments in `file`:
+//
+//	//lingo:ignore <slug>[,<slug>] <reason>
+//
+// suppresses violations on the line of the comment or, if the comment is on
+// a line of its own, on the line following it, while
+//
+//	//lingo:file-ignore <slug>[,<slug>] <reason>
+//
+// suppresses violations in the whole file. Suppressed violations are
+// registered in the Suppressed field of `report`. Line suppressions are
+// honoured only if `content` is provided.
+func (c *FileChecker) Check(file *ast.File, content string, report *Report) {
+	base := int(file.FileStart)
+	visitor := &fileVisitor{
+		checker: c.forFile(file),
+		content: content,
+		base:    base,
+		report:  &Report{},
 	}
-}
+	ast.Walk(visitor, file)
 
-func (c *FileChecker) visitGenDecl(decl *ast.GenDecl, report *Report) {
-	c.emit(decl, "", report)
+	c.applySeverities(visitor.report)
 
-	for _, spec := range decl.Specs {
-		switch spec := spec.(type) {
-		case *ast.TypeSpec:
-			c.visitTypeSpec(spec, report)
-		case *ast.ValueSpec:
-			c.visitValueSpec(spec, report)
+	suppressions := newSuppressions(
+		file, newLineIndex(content, base), c.requireReason)
+
+	for _, err := range visitor.report.Errors {
+		if suppressions.suppresses(err) {
+			report.Suppressed = append(report.Suppressed, err)
+		} else {
+			report.Errors = append(report.Errors, err)
 		}
 	}
+	report.Errors = append(report.Errors, suppressions.errors...)
 }
 
-func (c *FileChecker) visitTypeSpec(spec *ast.TypeSpec, report *Report) {
-	c.emit(spec, "", report)
+// CheckPackage checks `files`, which belong to the same package, with the
+// registered PackageChecker values and registers violations in `report`.
+// `contents` contains the source content of every file in `files`, if any.
+// Violations are suppressed by the comments in the file they occur in as in
+// Check.
+func (c *FileChecker) CheckPackage(
+	files []*ast.File,
+	contents []string,
+	report *Report) {
 
-	c.visitIdent(spec.Name, report)
-	c.visitExpr(spec.Type, report)
-}
+	packageReport := &Report{}
+	for _, checker := range c.packageCheckers {
+		var accepted []*ast.File
+		for _, file := range files {
+			if c.accepts(checker, file) {
+				accepted = append(accepted, file)
+			}
+		}
 
-func (c *FileChecker) visitValueSpec(spec *ast.ValueSpec, report *Report) {
-	for _, name := range spec.Names {
-		c.visitIdent(name, report)
+		if len(accepted) > 0 {
+			checker.CheckPackage(accepted, packageReport)
+		}
 	}
-}
-
-func (c *FileChecker) visitFuncDecl(decl *ast.FuncDecl, report *Report) {
-	c.emit(decl, "", report)
 
-	c.visitIdent(decl.Name, report)
-	c.visitFuncType(decl.Type, report)
-	c.visitBlockStmt(decl.Body, report)
-}
+	c.applySeverities(packageReport)
 
-func (c *FileChecker) visitDeclStmt(stmt *ast.DeclStmt, report *Report) {
-	c.visitDecl(stmt.Decl, report)
-}
+	fileSuppressions := make([]*suppressions, len(files))
+	for i, file := range files {
+		content := ""
+		if i < len(contents) {
+			content = contents[i]
+		}
 
-func (c *FileChecker) visitAssignStmt(stmt *ast.AssignStmt, report *Report) {
-	for _, expr := range stmt.Lhs {
-		c.visitExpr(expr, report)
+		fileSuppressions[i] = newSuppressions(
+			file, newLineIndex(content, int(file.FileStart)), c.requireReason)
 	}
 
-	for _, expr := range stmt.Rhs {
-		c.visitExpr(expr, report)
+	for _, err := range packageReport.Errors {
+		i := fileIndex(files, err.Pos)
+		if i >= 0 && fileSuppressions[i].suppresses(err) {
+			report.Suppressed = append(report.Suppressed, err)
+		} else {
+			report.Errors = append(report.Errors, err)
+		}
 	}
 }
 
-func (c *FileChecker) visitRangeStmt(stmt *ast.RangeStmt, report *Report) {
-	c.emit(stmt, "", report)
-
-	c.visitBlockStmt(stmt.Body, report)
-}
-
-func (c *FileChecker) visitExpr(expr ast.Expr, report *Report) {
-	switch expr := expr.(type) {
-	case *ast.Ident:
-		c.visitIdent(expr, report)
-	case *ast.BinaryExpr:
-		c.visitBinaryExpr(expr, report)
-	case *ast.FuncLit:
-		c.visitFuncLit(expr, report)
-	case *ast.StructType:
-		c.visitStructType(expr, report)
-	case *ast.InterfaceType:
-		c.visitInterfaceType(expr, report)
-	case *ast.FuncType:
-		c.visitFuncType(expr, report)
-	case *ast.CallExpr:
-		c.visitCallExpr(expr, report)
+// fileIndex returns the index of the file in `files` which contains `pos`
+// or -1 if there is no such file.
+func fileIndex(files []*ast.File, pos token.Pos) int {
+	for i, file := range files {
+		if file.FileStart <= pos && pos <= file.FileEnd {
+			return i
+		}
 	}
-}
 
-func (c *FileChecker) visitCallExpr(expr *ast.CallExpr, report *Report) {
-	c.visitExpr(expr.Fun, report)
+	return -1
 }
 
-func (c *FileChecker) visitIdent(ident *ast.Ident, report *Report) {
-	c.emit(ident, "", report)
+// applySeverities overrides the severities of the errors in `report` with
+// the severities set with SetSeverity.
+func (c *FileChecker) applySeverities(report *Report) {
+	for i, err := range report.Errors {
+		if severity, ok := c.severities[err.Slug]; ok {
+			report.Errors[i].Severity = severity
+		}
+	}
 }
 
-func (c *FileChecker) visitBinaryExpr(expr *ast.BinaryExpr, report *Report) {
-	c.emit(expr, "", report)
-	c.visitExpr(expr.X, report)
-	c.visitExpr(expr.Y, report)
-}
+// forFile returns a FileChecker with the registered checkers which accept
+// `file`.
+func (c *FileChecker) forFile(file *ast.File) *FileChecker {
+	if len(c.matchers) == 0 {
+		return c
+	}
+
+	checkers := map[string][]NodeChecker{}
+	for typeName, nodeCheckers := range c.checkers {
+		for _, checker := range nodeCheckers {
+			if c.accepts(checker, file) {
+				checkers[typeName] = append(checkers[typeName], checker)
+			}
+		}
+	}
 
-func (c *FileChecker) visitFuncLit(lit *ast.FuncLit, report *Report) {
-	c.visitFuncType(lit.Type, report)
-	c.visitBlockStmt(lit.Body, report)
+	fc := *c
+	fc.checkers = checkers
+	return &fc
 }
 
-func (c *FileChecker) visitBlockStmt(stmt *ast.BlockStmt, report *Report) {
-	for _, stmt := range stmt.List {
-		switch stmt := stmt.(type) {
-		case *ast.DeclStmt:
-			c.visitDeclStmt(stmt, report)
-		case *ast.AssignStmt:
-			c.visitAssignStmt(stmt, report)
-		case *ast.RangeStmt:
-			c.visitRangeStmt(stmt, report)
-		case *ast.ExprStmt:
-			c.visitExprStmt(stmt, report)
-		case *ast.GoStmt:
-			c.visitGoStmt(stmt, report)
-		case *ast.IfStmt:
-			c.visitIfStmt(stmt, report)
-		case *ast.ForStmt:
-			c.visitForStmt(stmt, report)
+// accepts reports whether `checker` checks `file`, i.e. the path of `file`
+// is accepted by all matchers of `checker`.
+func (c *FileChecker) accepts(checker NodeChecker, file *ast.File) bool {
+	matchers, ok := c.matchers[checker]
+	if !ok {
+		return true
+	}
+
+	path := ""
+	if c.fileSet != nil {
+		if tokenFile := c.fileSet.File(file.Pos()); tokenFile != nil {
+			path = tokenFile.Name()
 		}
 	}
-}
 
-func (c *FileChecker) visitGoStmt(stmt *ast.GoStmt, report *Report) {
-	c.visitCallExpr(stmt.Call, report)
-}
+	for _, matcher := range matchers {
+		if !matcher.Matches(path) {
+			return false
+		}
+	}
 
-func (c *FileChecker) visitExprStmt(stmt *ast.ExprStmt, report *Report) {
-	c.visitExpr(stmt.X, report)
+	return true
 }
 
-func (c *FileChecker) visitStructType(typ *ast.StructType, report *Report) {
-	c.emit(typ, "", report)
+func (c *FileChecker) emit(node ast.Node, content string, report *Report) {
+	typeName := reflect.TypeOf(node).String()
 
-	for _, field := range typ.Fields.List {
-		c.visitField(field, report)
+	for _, checker := range c.checkers[typeName] {
+		typed, ok := checker.(TypedNodeChecker)
+		if ok && c.info != nil {
+			typed.CheckTyped(node, c.info, report)
+		} else {
+			checker.Check(node, content, report)
+		}
 	}
 }
 
-func (c *FileChecker) visitInterfaceType(
-	typ *ast.InterfaceType,
-	report *Report) {
+// fileVisitor emits every node of a file to the node checkers registered
+// in a FileChecker.
+type fileVisitor struct {
+	checker *FileChecker
+	content string
+	base    int
+	report  *Report
+}
 
-	c.emit(typ, "", report)
+// Visit implements the ast.Visitor interface.
+func (v *fileVisitor) Visit(node ast.Node) ast.Visitor {
+	if node == nil {
+		return nil
+	}
 
-	for _, method := range typ.Methods.List {
-		c.visitField(method, report)
+	// Only the file node receives the source content.
+	content := ""
+	if _, ok := node.(*ast.File); ok && len(v.content) > 0 {
+		content = v.content[int(node.Pos())-v.base : int(node.End())-v.base]
 	}
-}
 
-func (c *FileChecker) visitFuncType(typ *ast.FuncType, report *Report) {
-	c.emit(typ, "", report)
+	v.checker.emit(node, content, v.report)
+
+	return v
 }
 
-func (c *FileChecker) visitField(field *ast.Field, report *Report) {
-	c.emit(field, "", report)
+// isQualifiedIdent reports whether `expr` refers to `qualifiedName`, e.g.
+// "context.Context", where the qualifier is the import path of a package.
+// Aliased imports are resolved only when `info` is available, otherwise
+// the package is expected to be referenced by the last element of its
+// import path.
+func isQualifiedIdent(expr ast.Expr, qualifiedName string, info *TypeInfo) bool {
+	sep := strings.LastIndex(qualifiedName, ".")
+	path, name := qualifiedName[:sep], qualifiedName[sep+1:]
 
-	for _, name := range field.Names {
-		c.visitIdent(name, report)
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
 	}
 
-	c.visitExpr(field.Type, report)
-}
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
 
-func (c *FileChecker) visitIfStmt(stmt *ast.IfStmt, report *Report) {
-	c.emit(stmt, "", report)
-}
+	if info == nil {
+		return ident.Name == path[strings.LastIndex(path, "/")+1:]
+	}
 
-func (c *FileChecker) visitForStmt(stmt *ast.ForStmt, report *Report) {
-	c.visitBlockStmt(stmt.Body, report)
+	pkgName, ok := info.Info.Uses[ident].(*types.PkgName)
+	return ok && pkgName.Imported().Path() == path
 }
@@ -4,10 +4,164 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
+	"reflect"
+	"testing"
 
+	. "github.com/s2gatev/lingo/checker"
+	"github.com/s2gatev/lingo/file"
+	"github.com/stretchr/testify/assert"
 	"golang.org/x/tools/go/loader"
 )
 
+func TestFileCheckerVisitsNestedNodes(t *testing.T) {
+	input := `
+		package test
+
+		func foo(ch chan int) {
+			if a {
+				_ = b[1]
+			} else if c {
+				_ = d[2:3]
+			}
+
+			for i := 0; e[i]; i++ {
+				defer f(g[4])
+			}
+
+			switch {
+			case h[5]:
+				return i[6]
+			}
+
+			select {
+			case ch <- j[7]:
+			}
+
+			_ = []int{k[8]}
+		}
+	`
+
+	nodes := &nodeCounter{counts: map[string]int{}}
+
+	checker := NewFileChecker()
+	checker.Register(nodes)
+
+	var report Report
+	checker.Check(ParseFileContent(input), "", &report)
+
+	assert.Equal(t,
+		map[string]int{
+			"*ast.IfStmt":       2,
+			"*ast.IndexExpr":    7,
+			"*ast.SliceExpr":    1,
+			"*ast.CaseClause":   1,
+			"*ast.CommClause":   1,
+			"*ast.CompositeLit": 1,
+		},
+		nodes.counts)
+}
+
+func TestFileCheckerRegisterMatching(t *testing.T) {
+	fileSet := token.NewFileSet()
+	content := "package foo\n\nfunc foo() {\n\tif a {\n\t}\n}\n"
+	matched, _ := parser.ParseFile(fileSet, "foo_test.go", content, 0)
+	skipped, _ := parser.ParseFile(fileSet, "foo.go", content, 0)
+
+	nodes := &nodeCounter{counts: map[string]int{}}
+
+	checker := NewFileChecker()
+	checker.SetFileSet(fileSet)
+	checker.RegisterMatching(nodes, file.Get("glob", map[string]interface{}{
+		"pattern": "*_test.go",
+	}))
+
+	var report Report
+	checker.Check(matched, "", &report)
+	checker.Check(skipped, "", &report)
+
+	assert.Equal(t, map[string]int{"*ast.IfStmt": 1}, nodes.counts)
+}
+
+func TestFileCheckerSetSeverity(t *testing.T) {
+	input := `
+		package test
+
+		func foo() {
+			if a {
+			}
+		}
+	`
+
+	checker := NewFileChecker()
+	checker.Register(&ifReporter{})
+	checker.SetSeverity("if", SeverityWarning)
+
+	var report Report
+	checker.Check(ParseFileContent(input), "", &report)
+
+	assert.Len(t, report.Errors, 1)
+	assert.Equal(t, SeverityWarning, report.Errors[0].Severity)
+}
+
+func TestSeverityString(t *testing.T) {
+	assert.Equal(t, "error", SeverityError.String())
+	assert.Equal(t, "warning", SeverityWarning.String())
+	assert.Equal(t, "info", SeverityInfo.String())
+	assert.Equal(t, "unknown", Severity(42).String())
+}
+
+func TestSeverityAtLeast(t *testing.T) {
+	assert.True(t, SeverityError.AtLeast(SeverityWarning))
+	assert.True(t, SeverityWarning.AtLeast(SeverityWarning))
+	assert.False(t, SeverityInfo.AtLeast(SeverityWarning))
+}
+
+func TestParseSeverity(t *testing.T) {
+	for _, severity := range []Severity{SeverityError, SeverityWarning, SeverityInfo} {
+		parsed, err := ParseSeverity(severity.String())
+		assert.NoError(t, err)
+		assert.Equal(t, severity, parsed)
+	}
+
+	_, err := ParseSeverity("fatal")
+	assert.EqualError(t, err,
+		"invalid severity fatal, expected error, warning or info")
+}
+
+type ifReporter struct {
+	dummyChecker
+}
+
+func (c *ifReporter) Register(fc *FileChecker) {
+	fc.On(&ast.IfStmt{}, c)
+}
+
+func (c *ifReporter) Check(node ast.Node, content string, report *Report) {
+	report.Errors = append(report.Errors, Error{
+		Slug: "if",
+		Pos:  node.Pos(),
+	})
+}
+
+type nodeCounter struct {
+	dummyChecker
+	counts map[string]int
+}
+
+func (c *nodeCounter) Register(fc *FileChecker) {
+	fc.On(&ast.IfStmt{}, c)
+	fc.On(&ast.IndexExpr{}, c)
+	fc.On(&ast.SliceExpr{}, c)
+	fc.On(&ast.CaseClause{}, c)
+	fc.On(&ast.CommClause{}, c)
+	fc.On(&ast.CompositeLit{}, c)
+}
+
+func (c *nodeCounter) Check(node ast.Node, content string, report *Report) {
+	c.counts[reflect.TypeOf(node).String()]++
+}
+
 // ParseFileContent parses `content` and returns an AST.
 func ParseFileContent(content string) *ast.File {
 	config := &loader.Config{
@@ -36,3 +190,77 @@ func ParseFileContentInSet(fileSet *token.FileSet, content string) *ast.File {
 
 	return file
 }
+
+// ParseTypedFileContent parses and type-checks `content` and returns an AST
+// together with its type information.
+func ParseTypedFileContent(content string) (*ast.File, *TypeInfo) {
+	config := &loader.Config{
+		ParserMode:  parser.ParseComments,
+		AllowErrors: true,
+		TypeChecker: types.Config{
+			Error: func(err error) {},
+		},
+	}
+
+	file, err := config.ParseFile("test.go", content)
+	if err != nil {
+		panic("could not parse file content")
+	}
+
+	config.CreateFromFiles("test", file)
+
+	program, err := config.Load()
+	if err != nil {
+		panic("could not type-check file content")
+	}
+
+	pkg := program.Created[0]
+	return file, &TypeInfo{
+		Package: pkg.Pkg,
+		Info:    &pkg.Info,
+	}
+}
+
+func TestDedent(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    string
+	}{
+		{
+			description: "common indentation",
+			input: `
+			if a {
+				foo()
+			}
+			`,
+			expected: "if a {\n\tfoo()\n}",
+		},
+		{
+			description: "no indentation",
+			input:       "foo()\nbar()",
+			expected:    "foo()\nbar()",
+		},
+		{
+			description: "blank lines",
+			input:       "\n\t\tfoo()\n\n\t\tbar()\n",
+			expected:    "foo()\n\nbar()",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.expected, Dedent(test.input))
+		})
+	}
+}
+
+// mustNew returns the NodeChecker `c` constructed by a NodeCheckerConstructor
+// and panics if its construction failed.
+func mustNew(c NodeChecker, err error) NodeChecker {
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return c
+}
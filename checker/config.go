@@ -0,0 +1,238 @@
+package checker
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/uber-go/mapdecode"
+)
+
+// ConfigError is an error in the configuration of a checker.
+type ConfigError struct {
+
+	// Slug is the slug of the checker.
+	Slug string
+
+	// Option is the name of the invalid option or empty if the error is not
+	// caused by a single option.
+	Option string
+
+	// Message describes the error.
+	Message string
+}
+
+// Error implements the error interface.
+func (e *ConfigError) Error() string {
+	if e.Option == "" {
+		return fmt.Sprintf("invalid config of checker %s: %s", e.Slug, e.Message)
+	}
+
+	return fmt.Sprintf("invalid config of checker %s: %s: %s",
+		e.Slug, e.Option, e.Message)
+}
+
+// ConfigErrors are several errors in the configuration of a checker.
+type ConfigErrors []*ConfigError
+
+// Error implements the error interface.
+func (e ConfigErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// configErrors returns nil if `errs` is empty, its only error or all of them
+// as ConfigErrors.
+func configErrors(errs []*ConfigError) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return ConfigErrors(errs)
+	}
+}
+
+// mergeConfigErrors returns the errors in the configuration of a checker
+// in `errs` as a single error. Nil errors are skipped.
+func mergeConfigErrors(errs ...error) error {
+	var merged []*ConfigError
+	for _, err := range errs {
+		switch err := err.(type) {
+		case *ConfigError:
+			merged = append(merged, err)
+		case ConfigErrors:
+			merged = append(merged, err...)
+		}
+	}
+
+	return configErrors(merged)
+}
+
+// checkNotNegative returns an error for every option of the checker
+// referenced by `slug` whose value in `options` is negative.
+func checkNotNegative(slug string, options map[string]int) error {
+	return checkMinimum(slug, options, 0)
+}
+
+// checkPositive returns an error for every option of the checker referenced
+// by `slug` whose value in `options` is not positive.
+func checkPositive(slug string, options map[string]int) error {
+	return checkMinimum(slug, options, 1)
+}
+
+// checkMinimum returns an error for every option of the checker referenced
+// by `slug` whose value in `options` is less than `min`, either 0 or 1.
+func checkMinimum(slug string, options map[string]int, min int) error {
+	message := "must not be negative"
+	if min > 0 {
+		message = "must be positive"
+	}
+
+	var names []string
+	for name := range options {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []*ConfigError
+	for _, name := range names {
+		if options[name] < min {
+			errs = append(errs, &ConfigError{
+				Slug:    slug,
+				Option:  name,
+				Message: message,
+			})
+		}
+	}
+
+	return configErrors(errs)
+}
+
+// decodeConfig decodes `configData` into `config`, a pointer to the
+// configuration struct of the checker referenced by `slug`. Options which
+// are not fields of the struct and values of a wrong type are rejected,
+// while the valid options are still decoded.
+func decodeConfig(slug string, configData, config interface{}) error {
+	data := reflect.ValueOf(configData)
+	if data.Kind() != reflect.Map {
+		return decodeOptions(slug, configData, config)
+	}
+
+	values := map[string]interface{}{}
+	for _, key := range data.MapKeys() {
+		values[fmt.Sprint(key.Interface())] = data.MapIndex(key).Interface()
+	}
+
+	errs := checkOptions(slug, values)
+	for _, err := range errs {
+		delete(values, err.Option)
+	}
+
+	if err := decodeOptions(slug, values, config); err != nil {
+		return err
+	}
+
+	return configErrors(errs)
+}
+
+// decodeOptions decodes `options` into `config` unless it is nil.
+func decodeOptions(slug string, options, config interface{}) error {
+	if config == nil {
+		return nil
+	}
+
+	if err := mapdecode.Decode(config, options); err != nil {
+		return &ConfigError{
+			Slug:    slug,
+			Message: err.Error(),
+		}
+	}
+
+	return nil
+}
+
+// checkOptions checks that every key of `values` is an option of the
+// checker referenced by `slug` and that its value has the type of the option.
+// All invalid options are reported.
+func checkOptions(slug string, values map[string]interface{}) []*ConfigError {
+	var keys []string
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []*ConfigError
+	fields := optionFields(slug)
+	for _, key := range keys {
+		field, ok := fields[key]
+		if !ok {
+			errs = append(errs, &ConfigError{
+				Slug:    slug,
+				Option:  key,
+				Message: "unknown option",
+			})
+			continue
+		}
+
+		value := reflect.New(field.Type).Interface()
+		err := mapdecode.Decode(value, values[key])
+		if err != nil || !isIntegral(field.Type, values[key]) {
+			errs = append(errs, &ConfigError{
+				Slug:   slug,
+				Option: key,
+				Message: fmt.Sprintf("invalid value %v, expected %s",
+					values[key], field.Type),
+			})
+		}
+	}
+
+	return errs
+}
+
+// isIntegral reports whether `value` can be decoded into `valueType`
+// without losing a fraction. Values of non-integer types are always
+// integral.
+func isIntegral(valueType reflect.Type, value interface{}) bool {
+	switch valueType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+	default:
+		return true
+	}
+
+	number := reflect.ValueOf(value)
+	switch number.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return number.Float() == math.Trunc(number.Float())
+	default:
+		return true
+	}
+}
+
+// optionFields returns the fields of the configuration struct of the
+// checker referenced by `slug` mapped by the names of their options.
+func optionFields(slug string) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+
+	configType, ok := configs[slug]
+	if !ok {
+		return fields
+	}
+
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		if name := optionName(field); name != "" {
+			fields[name] = field
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,236 @@
+package checker_test
+
+import (
+	"testing"
+
+	. "github.com/s2gatev/lingo/checker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetConfig(t *testing.T) {
+	tests := []struct {
+		description string
+		slug        string
+		config      interface{}
+		expected    error
+	}{
+		{
+			description: "valid options",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_length": 80,
+				"tab_width":  4,
+			},
+		},
+		{
+			description: "no options",
+			slug:        "local_return",
+			config:      map[string]interface{}{},
+		},
+		{
+			description: "nested yaml map",
+			slug:        "func_cyclo",
+			config: map[interface{}]interface{}{
+				"max": 10,
+			},
+		},
+		{
+			description: "unknown option",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_lenght": 80,
+			},
+			expected: &ConfigError{
+				Slug:    "line_length",
+				Option:  "max_lenght",
+				Message: "unknown option",
+			},
+		},
+		{
+			description: "option of checker without options",
+			slug:        "local_return",
+			config: map[string]interface{}{
+				"max": 1,
+			},
+			expected: &ConfigError{
+				Slug:    "local_return",
+				Option:  "max",
+				Message: "unknown option",
+			},
+		},
+		{
+			description: "type mismatch",
+			slug:        "exported_ident_doc",
+			config: map[string]interface{}{
+				"has_ident_prefix": []string{"yes"},
+			},
+			expected: &ConfigError{
+				Slug:    "exported_ident_doc",
+				Option:  "has_ident_prefix",
+				Message: "invalid value [yes], expected bool",
+			},
+		},
+		{
+			description: "negative max",
+			slug:        "func_params_count",
+			config: map[string]interface{}{
+				"max": -1,
+			},
+			expected: &ConfigError{
+				Slug:    "func_params_count",
+				Option:  "max",
+				Message: "must not be negative",
+			},
+		},
+		{
+			description: "zero max length",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_length": 0,
+			},
+		},
+		{
+			description: "no options",
+			slug:        "func_results_count",
+			config:      map[string]interface{}{},
+		},
+		{
+			description: "fractional max",
+			slug:        "func_cyclo",
+			config: map[string]interface{}{
+				"max": 1.5,
+			},
+			expected: &ConfigError{
+				Slug:    "func_cyclo",
+				Option:  "max",
+				Message: "invalid value 1.5, expected int",
+			},
+		},
+		{
+			description: "integral float max",
+			slug:        "func_cyclo",
+			config: map[string]interface{}{
+				"max": 12.0,
+			},
+		},
+		{
+			description: "several invalid options",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_lenght": 80,
+				"tab_width":  "wide",
+			},
+			expected: ConfigErrors{
+				{
+					Slug:    "line_length",
+					Option:  "max_lenght",
+					Message: "unknown option",
+				},
+				{
+					Slug:    "line_length",
+					Option:  "tab_width",
+					Message: "invalid value wide, expected int",
+				},
+			},
+		},
+		{
+			description: "invalid and negative options",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_length": -1,
+				"tab_width":  1.5,
+			},
+			expected: ConfigErrors{
+				{
+					Slug:    "line_length",
+					Option:  "tab_width",
+					Message: "invalid value 1.5, expected int",
+				},
+				{
+					Slug:    "line_length",
+					Option:  "max_length",
+					Message: "must not be negative",
+				},
+			},
+		},
+		{
+			description: "several out of range options",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_length": -80,
+				"tab_width":  0,
+			},
+			expected: ConfigErrors{
+				{
+					Slug:    "line_length",
+					Option:  "max_length",
+					Message: "must not be negative",
+				},
+				{
+					Slug:    "line_length",
+					Option:  "tab_width",
+					Message: "must be positive",
+				},
+			},
+		},
+		{
+			description: "zero tab width",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_length": 80,
+				"tab_width":  0,
+			},
+			expected: &ConfigError{
+				Slug:    "line_length",
+				Option:  "tab_width",
+				Message: "must be positive",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			c, err := Get(test.slug, test.config)
+			assert.Equal(t, test.expected, err)
+			if test.expected == nil {
+				assert.NotNil(t, c)
+			}
+		})
+	}
+}
+
+func TestConfigErrorError(t *testing.T) {
+	err := &ConfigError{
+		Slug:    "line_length",
+		Option:  "tab_width",
+		Message: "must be positive",
+	}
+	assert.Equal(t,
+		"invalid config of checker line_length: tab_width: must be positive",
+		err.Error())
+
+	err.Option = ""
+	assert.Equal(t,
+		"invalid config of checker line_length: must be positive",
+		err.Error())
+}
+
+func TestConfigErrorsError(t *testing.T) {
+	err := ConfigErrors{
+		{
+			Slug:    "line_length",
+			Option:  "max_length",
+			Message: "must not be negative",
+		},
+		{
+			Slug:    "line_length",
+			Option:  "tab_width",
+			Message: "must be positive",
+		},
+	}
+	assert.Equal(t,
+		"invalid config of checker line_length: max_length: must not be negative\n"+
+			"invalid config of checker line_length: tab_width: must be positive",
+		err.Error())
+}
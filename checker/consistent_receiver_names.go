@@ -7,22 +7,24 @@ import (
 
 func init() {
 	must(Register(
-		"consistent_receiver_names",
+		consistentReceiverNamesSlug,
 		NewConsistentReceiverNamesChecker))
 }
 
+const consistentReceiverNamesSlug = "consistent_receiver_names"
+
 // ConsistentReceiverNamesChecker checks that method receivers of a type
-// are named consistently.
-type ConsistentReceiverNamesChecker struct {
-	receiverNames map[string]string
-}
+// are named consistently across all files of a package.
+type ConsistentReceiverNamesChecker struct{}
 
 // NewConsistentReceiverNamesChecker constructs a
 // ConsistentReceiverNamesChecker.
-func NewConsistentReceiverNamesChecker(configData interface{}) NodeChecker {
-	return &ConsistentReceiverNamesChecker{
-		receiverNames: map[string]string{},
+func NewConsistentReceiverNamesChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(consistentReceiverNamesSlug, configData, nil); err != nil {
+		return nil, err
 	}
+
+	return &ConsistentReceiverNamesChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -52,17 +54,34 @@ func (t *Executable) Cancel() {}
 }
 
 // Register implements the NodeChecker interface.
-func (c *ConsistentReceiverNamesChecker) Register(fc *FileChecker) {
-	fc.On(&ast.FuncDecl{}, c)
-}
+func (c *ConsistentReceiverNamesChecker) Register(fc *FileChecker) {}
 
 // Check implements the NodeChecker interface.
 func (c *ConsistentReceiverNamesChecker) Check(
 	node ast.Node,
 	content string,
 	report *Report) {
+}
+
+// CheckPackage implements the PackageChecker interface.
+func (c *ConsistentReceiverNamesChecker) CheckPackage(
+	files []*ast.File,
+	report *Report) {
+
+	receiverNames := map[string]string{}
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if decl, ok := decl.(*ast.FuncDecl); ok {
+				c.checkFuncDecl(decl, receiverNames, report)
+			}
+		}
+	}
+}
 
-	decl := node.(*ast.FuncDecl)
+func (c *ConsistentReceiverNamesChecker) checkFuncDecl(
+	decl *ast.FuncDecl,
+	receiverNames map[string]string,
+	report *Report) {
 
 	if decl.Recv == nil || len(decl.Recv.List) == 0 {
 		return
@@ -84,12 +103,14 @@ func (c *ConsistentReceiverNamesChecker) Check(
 		}
 	}
 
-	expectedName, ok := c.receiverNames[typeName]
+	expectedName, ok := receiverNames[typeName]
 	if !ok {
-		c.receiverNames[typeName] = name
+		receiverNames[typeName] = name
 	} else if name != expectedName {
 		report.Errors = append(report.Errors, Error{
-			Pos: node.Pos(),
+			Slug: consistentReceiverNamesSlug,
+			Pos:  decl.Pos(),
+			End:  decl.Recv.End(),
 			Message: fmt.Sprintf("receivers in methods for type '%s' "+
 				"should have the same names", typeName),
 		})
@@ -1,6 +1,8 @@
 package checker_test
 
 import (
+	"go/ast"
+	"go/token"
 	"testing"
 
 	. "github.com/s2gatev/lingo/checker"
@@ -27,7 +29,9 @@ func TestConsistentReceiverNamesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "consistent_receiver_names",
 						Pos:     49,
+						End:     61,
 						Message: "receivers in methods for type 'Foo' should have the same names",
 					},
 				},
@@ -44,7 +48,9 @@ func TestConsistentReceiverNamesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "consistent_receiver_names",
 						Pos:     50,
+						End:     63,
 						Message: "receivers in methods for type 'Foo' should have the same names",
 					},
 				},
@@ -79,12 +85,85 @@ func TestConsistentReceiverNamesChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewConsistentReceiverNamesChecker(nil))
+			checker.Register(mustNew(NewConsistentReceiverNamesChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
-			checker.Check(file, "", &report)
+			checker.CheckPackage([]*ast.File{file}, nil, &report)
 			assert.Equal(t, test.expected, report)
 		})
 	}
 }
+
+func TestConsistentReceiverNamesCheckerPackage(t *testing.T) {
+	checker := NewFileChecker()
+	checker.Register(mustNew(NewConsistentReceiverNamesChecker(nil)))
+
+	fileSet := token.NewFileSet()
+	foo1 := ParseFileContentInSet(fileSet, `
+		package foo
+
+		func (f Foo) Foo() {}
+	`)
+	foo2 := ParseFileContentInSet(fileSet, `
+		package foo
+
+		func (b Foo) Bar() {}
+	`)
+	bar := ParseFileContentInSet(fileSet, `
+		package bar
+
+		func (x Foo) Qux() {}
+	`)
+
+	var fooReport Report
+	checker.CheckPackage([]*ast.File{foo1, foo2}, nil, &fooReport)
+	assert.Equal(t,
+		Report{
+			Errors: []Error{
+				{
+					Slug:    "consistent_receiver_names",
+					Pos:     foo2.Decls[0].Pos(),
+					End:     foo2.Decls[0].(*ast.FuncDecl).Recv.End(),
+					Message: "receivers in methods for type 'Foo' should have the same names",
+				},
+			},
+		},
+		fooReport)
+
+	var barReport Report
+	checker.CheckPackage([]*ast.File{bar}, nil, &barReport)
+	assert.Equal(t, Report{}, barReport)
+}
+
+func TestConsistentReceiverNamesCheckerSuppression(t *testing.T) {
+	checker := NewFileChecker()
+	checker.Register(mustNew(NewConsistentReceiverNamesChecker(nil)))
+
+	fileSet := token.NewFileSet()
+	lineContent := `package foo
+
+func (f Foo) Foo() {}
+
+//lingo:ignore consistent_receiver_names kept for compatibility
+func (b Foo) Bar() {}
+`
+	fileContent := `package foo
+
+//lingo:file-ignore consistent_receiver_names generated code
+
+func (f Foo) Foo() {}
+
+func (b Foo) Bar() {}
+`
+	contents := []string{lineContent, fileContent}
+
+	for _, content := range contents {
+		file := ParseFileContentInSet(fileSet, content)
+
+		var report Report
+		checker.CheckPackage([]*ast.File{file}, []string{content}, &report)
+		assert.Empty(t, report.Errors)
+		assert.Len(t, report.Suppressed, 1)
+	}
+}
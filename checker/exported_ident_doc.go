@@ -3,21 +3,23 @@ package checker
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"strings"
-
-	"github.com/uber-go/mapdecode"
 )
 
 func init() {
-	must(Register("exported_ident_doc", NewExportedIdentDocChecker))
+	must(Register(exportedIdentDocSlug, NewExportedIdentDocChecker))
+	must(RegisterConfig(exportedIdentDocSlug, ExportedIdentDocCheckerConfig{}))
 }
 
+const exportedIdentDocSlug = "exported_ident_doc"
+
 // ExportedIdentDocCheckerConfig describes the configuration of a ExportedIdentDocChecker.
 type ExportedIdentDocCheckerConfig struct {
 
 	// HasIdentPrefix signals if the checker should ensure that every doc comment begins
 	// with the name of the item it describes.
-	HasIdentPrefix bool `mapdecode:"has_ident_prefix"`
+	HasIdentPrefix bool `mapdecode:"has_ident_prefix" doc:"start docs with the name"`
 }
 
 // ExportedIdentDocChecker checks the documentation of exported
@@ -27,15 +29,21 @@ type ExportedIdentDocChecker struct {
 }
 
 // NewExportedIdentDocChecker constructs a ExportedIdentDocChecker.
-func NewExportedIdentDocChecker(configData interface{}) NodeChecker {
+func NewExportedIdentDocChecker(configData interface{}) (NodeChecker, error) {
 	var config ExportedIdentDocCheckerConfig
-	if err := mapdecode.Decode(&config, configData); err != nil {
-		fmt.Println(err)
-		return nil
+	if err := decodeConfig(exportedIdentDocSlug, configData, &config); err != nil {
+		return nil, err
 	}
 
 	return &ExportedIdentDocChecker{
 		hasIdentPrefix: config.HasIdentPrefix,
+	}, nil
+}
+
+// Config implements the ConfiguredChecker interface.
+func (c *ExportedIdentDocChecker) Config() interface{} {
+	return ExportedIdentDocCheckerConfig{
+		HasIdentPrefix: c.hasIdentPrefix,
 	}
 }
 
@@ -92,7 +100,8 @@ var Runners []Runner
 func (c *ExportedIdentDocChecker) Register(fc *FileChecker) {
 	fc.On(&ast.GenDecl{}, c)
 	fc.On(&ast.FuncDecl{}, c)
-	fc.On(&ast.Field{}, c)
+	fc.On(&ast.StructType{}, c)
+	fc.On(&ast.InterfaceType{}, c)
 }
 
 // Check implements the NodeChecker interface.
@@ -106,20 +115,26 @@ func (c *ExportedIdentDocChecker) Check(
 		for _, spec := range node.Specs {
 			switch spec := spec.(type) {
 			case *ast.TypeSpec:
-				c.checkTypeSpec(node.Doc, spec, report)
+				c.checkTypeSpec(node, spec, report)
 			case *ast.ValueSpec:
-				c.checkValueSpec(node.Doc, spec, report)
+				c.checkValueSpec(node, spec, report)
 			}
 		}
 	case *ast.FuncDecl:
 		c.checkFuncDecl(node, report)
-	case *ast.Field:
-		c.checkField(node, report)
+	case *ast.StructType:
+		for _, field := range node.Fields.List {
+			c.checkField(field, report)
+		}
+	case *ast.InterfaceType:
+		for _, method := range node.Methods.List {
+			c.checkField(method, report)
+		}
 	}
 }
 
 func (c *ExportedIdentDocChecker) checkTypeSpec(
-	doc *ast.CommentGroup,
+	decl *ast.GenDecl,
 	spec *ast.TypeSpec,
 	report *Report) {
 
@@ -127,20 +142,27 @@ func (c *ExportedIdentDocChecker) checkTypeSpec(
 		return
 	}
 
-	if doc == nil {
+	if decl.Doc == nil && spec.Doc == nil {
 		report.Errors = append(report.Errors, Error{
-			Pos: spec.Pos(),
+			Slug: exportedIdentDocSlug,
+			Pos:  spec.Pos(),
+			End:  spec.Name.End(),
 			Message: fmt.Sprintf("exported identifier '%s' is not documented",
 				spec.Name.Name),
+			Edits: docStub(specDocPos(decl, spec), spec.Name.Name),
 		})
 		return
 	}
 
-	c.checkPrefix(spec.Name.Name, doc, report)
+	identDoc := spec.Doc
+	if spec.Doc == nil {
+		identDoc = decl.Doc
+	}
+	c.checkPrefix(spec.Name.Name, identDoc, report)
 }
 
 func (c *ExportedIdentDocChecker) checkValueSpec(
-	doc *ast.CommentGroup,
+	decl *ast.GenDecl,
 	spec *ast.ValueSpec,
 	report *Report) {
 
@@ -149,18 +171,23 @@ func (c *ExportedIdentDocChecker) checkValueSpec(
 			continue
 		}
 
-		if doc == nil && spec.Doc == nil {
+		// A single comment documents all names of the spec so their
+		// violations share a stub.
+		if decl.Doc == nil && spec.Doc == nil {
 			report.Errors = append(report.Errors, Error{
-				Pos: spec.Pos(),
+				Slug: exportedIdentDocSlug,
+				Pos:  spec.Pos(),
+				End:  name.End(),
 				Message: fmt.Sprintf("exported identifier '%s' is not documented",
 					name.Name),
+				Edits: docStub(specDocPos(decl, spec), spec.Names[0].Name),
 			})
 			continue
 		}
 
 		identDoc := spec.Doc
 		if spec.Doc == nil {
-			identDoc = doc
+			identDoc = decl.Doc
 		}
 		c.checkPrefix(name.Name, identDoc, report)
 	}
@@ -176,9 +203,12 @@ func (c *ExportedIdentDocChecker) checkFuncDecl(
 
 	if decl.Doc == nil {
 		report.Errors = append(report.Errors, Error{
-			Pos: decl.Pos(),
+			Slug: exportedIdentDocSlug,
+			Pos:  decl.Pos(),
+			End:  decl.Name.End(),
 			Message: fmt.Sprintf("exported identifier '%s' is not documented",
 				decl.Name.Name),
+			Edits: docStub(decl.Pos(), decl.Name.Name),
 		})
 		return
 	}
@@ -204,9 +234,12 @@ func (c *ExportedIdentDocChecker) checkField(
 	if field.Doc == nil {
 		for _, name := range exported {
 			report.Errors = append(report.Errors, Error{
-				Pos: field.Pos(),
+				Slug: exportedIdentDocSlug,
+				Pos:  field.Pos(),
+				End:  field.End(),
 				Message: fmt.Sprintf("exported identifier '%s' is not documented",
 					name),
+				Edits: docStub(field.Pos(), exported[0]),
 			})
 		}
 		return
@@ -228,8 +261,31 @@ func (c *ExportedIdentDocChecker) checkPrefix(
 
 	if !strings.HasPrefix(doc.Text(), name) {
 		report.Errors = append(report.Errors, Error{
+			Slug:    exportedIdentDocSlug,
 			Pos:     doc.Pos(),
+			End:     doc.End(),
 			Message: fmt.Sprintf("expected the comment to start with '%s'", name),
 		})
 	}
 }
+
+// specDocPos returns the position where the doc comment of `spec` belongs.
+func specDocPos(decl *ast.GenDecl, spec ast.Spec) token.Pos {
+	if decl.Lparen.IsValid() {
+		return spec.Pos()
+	}
+
+	return decl.Pos()
+}
+
+// docStub returns an edit which inserts a stub doc comment for `name` at
+// `pos`.
+func docStub(pos token.Pos, name string) []Edit {
+	return []Edit{
+		{
+			Pos:     pos,
+			End:     pos,
+			NewText: fmt.Sprintf("// %s ...\n", name),
+		},
+	}
+}
@@ -31,8 +31,17 @@ func TestExportedIdentDocChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     113,
+						End:     120,
 						Message: "exported identifier 'FooBar3' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     108,
+								End:     108,
+								NewText: "// FooBar3 ...\n",
+							},
+						},
 					},
 				},
 			},
@@ -52,8 +61,17 @@ func TestExportedIdentDocChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     117,
+						End:     127,
 						Message: "exported identifier 'TheAnswer3' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     111,
+								End:     111,
+								NewText: "// TheAnswer3 ...\n",
+							},
+						},
 					},
 				},
 			},
@@ -73,8 +91,17 @@ func TestExportedIdentDocChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     111,
+						End:     121,
 						Message: "exported identifier 'TheAnswer3' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     107,
+								End:     107,
+								NewText: "// TheAnswer3 ...\n",
+							},
+						},
 					},
 				},
 			},
@@ -94,8 +121,17 @@ func TestExportedIdentDocChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     91,
+						End:     100,
 						Message: "exported identifier 'Foo3' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     91,
+								End:     91,
+								NewText: "// Foo3 ...\n",
+							},
+						},
 					},
 				},
 			},
@@ -122,16 +158,43 @@ func TestExportedIdentDocChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     139,
+						End:     150,
 						Message: "exported identifier 'FooBar3' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     139,
+								End:     139,
+								NewText: "// FooBar3 ...\n",
+							},
+						},
 					},
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     157,
+						End:     186,
 						Message: "exported identifier 'FooBar4' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     157,
+								End:     157,
+								NewText: "// FooBar4 ...\n",
+							},
+						},
 					},
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     157,
+						End:     186,
 						Message: "exported identifier 'FooBar6' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     157,
+								End:     157,
+								NewText: "// FooBar4 ...\n",
+							},
+						},
 					},
 				},
 			},
@@ -153,8 +216,17 @@ func TestExportedIdentDocChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     146,
+						End:     159,
 						Message: "exported identifier 'FooBar3' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     146,
+								End:     146,
+								NewText: "// FooBar3 ...\n",
+							},
+						},
 					},
 				},
 			},
@@ -182,7 +254,7 @@ func TestExportedIdentDocChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewExportedIdentDocChecker(nil))
+			checker.Register(mustNew(NewExportedIdentDocChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -216,7 +288,9 @@ func TestExportedIdentDocCheckerPrefix(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     24,
+						End:     46,
 						Message: "expected the comment to start with 'FooBar1'",
 					},
 				},
@@ -238,12 +312,23 @@ func TestExportedIdentDocCheckerPrefix(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     24,
+						End:     46,
 						Message: "expected the comment to start with 'FooBar1'",
 					},
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     130,
+						End:     137,
 						Message: "exported identifier 'FooBar3' is not documented",
+						Edits: []Edit{
+							{
+								Pos:     126,
+								End:     126,
+								NewText: "// FooBar3 ...\n",
+							},
+						},
 					},
 				},
 			},
@@ -265,7 +350,9 @@ func TestExportedIdentDocCheckerPrefix(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     62,
+						End:     90,
 						Message: "expected the comment to start with 'FooBar1'",
 					},
 				},
@@ -285,7 +372,9 @@ func TestExportedIdentDocCheckerPrefix(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     24,
+						End:     46,
 						Message: "expected the comment to start with 'Foo1'",
 					},
 				},
@@ -312,22 +401,38 @@ func TestExportedIdentDocCheckerPrefix(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     24,
+						End:     46,
 						Message: "expected the comment to start with 'Foo'",
 					},
 					{
+						Slug:    "exported_ident_doc",
 						Pos:     75,
+						End:     97,
 						Message: "expected the comment to start with 'FooBar1'",
 					},
 				},
 			},
 		},
+		{
+			description: "func params",
+			input: `
+				package test
+
+				// Foo is documented.
+				func Foo(Bar int) (Qux error) {}
+			`,
+			expected: Report{
+				Errors: nil,
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewExportedIdentDocChecker(config))
+			checker.Register(mustNew(NewExportedIdentDocChecker(config)))
 
 			file := ParseFileContent(test.input)
 			var report Report
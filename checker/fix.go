@@ -0,0 +1,106 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"sort"
+)
+
+// FixReport describes the result of fixing the violations in a file.
+type FixReport struct {
+
+	// Content is the fixed content of the file formatted with gofmt.
+	Content string
+
+	// Fixed contains the violations which were fixed.
+	Fixed []Error
+
+	// Remaining contains the violations which were not fixed.
+	Remaining []Error
+}
+
+// Fix applies the edits of the violations in `report` to `content` which is
+// the content of `file` and formats the result with gofmt. A violation is
+// fixed only if its edits do not overlap the edits of the violations fixed
+// before it.
+func Fix(file *ast.File, content string, report *Report) (*FixReport, error) {
+	fixReport := &FixReport{
+		Content: content,
+	}
+
+	var edits []Edit
+	for _, err := range report.Errors {
+		switch {
+		case len(err.Edits) == 0:
+			fixReport.Remaining = append(fixReport.Remaining, err)
+		case contains(edits, err.Edits):
+			// Violations which share a fix, e.g. names of the same
+			// declaration, are fixed together.
+			fixReport.Fixed = append(fixReport.Fixed, err)
+		case overlap(edits, err.Edits):
+			fixReport.Remaining = append(fixReport.Remaining, err)
+		default:
+			edits = append(edits, err.Edits...)
+			fixReport.Fixed = append(fixReport.Fixed, err)
+		}
+	}
+
+	if len(edits) == 0 {
+		return fixReport, nil
+	}
+
+	// Edits are applied from the end of the file so that the offsets of the
+	// remaining edits stay valid.
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].Pos > edits[j].Pos
+	})
+
+	base := int(file.FileStart)
+	for _, edit := range edits {
+		content = content[:int(edit.Pos)-base] +
+			edit.NewText +
+			content[int(edit.End)-base:]
+	}
+
+	formatted, err := format.Source([]byte(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to format fixed file: %s", err)
+	}
+
+	fixReport.Content = string(formatted)
+	return fixReport, nil
+}
+
+// contains reports whether all `edits` are already `accepted`.
+func contains(accepted, edits []Edit) bool {
+	for _, edit := range edits {
+		found := false
+		for _, a := range accepted {
+			if a == edit {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// overlap reports whether any of `edits` overlaps any of `accepted`. Two
+// insertions at the same position overlap as their order is ambiguous.
+func overlap(accepted, edits []Edit) bool {
+	for _, a := range accepted {
+		for _, b := range edits {
+			if a.Pos == b.Pos || (a.Pos < b.End && b.Pos < a.End) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,307 @@
+package checker_test
+
+import (
+	"testing"
+
+	. "github.com/s2gatev/lingo/checker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFix(t *testing.T) {
+	type test struct {
+		description string
+		checkers    []NodeChecker
+		input       string
+		expected    string
+		remaining   []string
+	}
+
+	tests := []test{
+		{
+			description: "unneeded import alias",
+			checkers:    []NodeChecker{mustNew(NewUnneededImportAliasChecker(nil))},
+			input: `package test
+
+import something "math/rand"
+
+var _ = something.Int
+`,
+			expected: `package test
+
+import "math/rand"
+
+var _ = rand.Int
+`,
+		},
+		{
+			description: "unneeded import alias with conflicting name",
+			checkers:    []NodeChecker{mustNew(NewUnneededImportAliasChecker(nil))},
+			input: `package test
+
+import something "math/rand"
+
+var rand = something.Int
+`,
+			expected: `package test
+
+import something "math/rand"
+
+var rand = something.Int
+`,
+			remaining: []string{"unneeded package alias: something"},
+		},
+		{
+			description: "import alias equal to the package name",
+			checkers:    []NodeChecker{mustNew(NewUnneededImportAliasChecker(nil))},
+			input: `package test
+
+import strings "strings"
+
+var _ = strings.TrimSpace
+`,
+			expected: `package test
+
+import "strings"
+
+var _ = strings.TrimSpace
+`,
+		},
+		{
+			description: "redundant else",
+			checkers:    []NodeChecker{mustNew(NewRedundantElseChecker(nil))},
+			input: `package test
+
+func foo(a bool) int {
+	if a {
+		return 1
+	} else {
+		bar()
+	}
+	return 2
+}
+`,
+			expected: `package test
+
+func foo(a bool) int {
+	if a {
+		return 1
+	}
+	bar()
+	return 2
+}
+`,
+		},
+		{
+			description: "redundant else with several statements",
+			checkers:    []NodeChecker{mustNew(NewRedundantElseChecker(nil))},
+			input: `package test
+
+func foo(a bool) int {
+	for {
+		if a {
+			break
+		} else {
+			bar()
+
+			baz()
+		}
+	}
+	return 2
+}
+`,
+			expected: `package test
+
+func foo(a bool) int {
+	for {
+		if a {
+			break
+		}
+		bar()
+
+		baz()
+	}
+	return 2
+}
+`,
+		},
+		{
+			description: "redundant empty else",
+			checkers:    []NodeChecker{mustNew(NewRedundantElseChecker(nil))},
+			input: `package test
+
+func foo(a bool) int {
+	if a {
+		return 1
+	} else {
+	}
+	return 2
+}
+`,
+			expected: `package test
+
+func foo(a bool) int {
+	if a {
+		return 1
+	}
+	return 2
+}
+`,
+		},
+		{
+			description: "redundant else if",
+			checkers:    []NodeChecker{mustNew(NewRedundantElseChecker(nil))},
+			input: `package test
+
+func foo(a, b bool) int {
+	if a {
+		return 1
+	} else if b {
+		return 2
+	} else {
+		bar()
+	}
+	return 3
+}
+`,
+			expected: `package test
+
+func foo(a, b bool) int {
+	if a {
+		return 1
+	}
+	if b {
+		return 2
+	} else {
+		bar()
+	}
+	return 3
+}
+`,
+			remaining: []string{"unexpected else after return statement"},
+		},
+		{
+			description: "redundant else with declarations",
+			checkers:    []NodeChecker{mustNew(NewRedundantElseChecker(nil))},
+			input: `package test
+
+func foo(a bool) int {
+	if a {
+		return 1
+	} else {
+		b := 2
+		return b
+	}
+}
+`,
+			expected: `package test
+
+func foo(a bool) int {
+	if a {
+		return 1
+	} else {
+		b := 2
+		return b
+	}
+}
+`,
+			remaining: []string{"unexpected else after return statement"},
+		},
+		{
+			description: "left quantifiers",
+			checkers:    []NodeChecker{mustNew(NewLeftQuantifiersChecker(nil))},
+			input: `package test
+
+var _ = a*b + 3
+var _ = a + b | 3
+var _ = a + "3"
+`,
+			expected: `package test
+
+var _ = 3 + a*b
+var _ = 3 | (a + b)
+var _ = a + "3"
+`,
+			remaining: []string{"the left operand should be a basic literal"},
+		},
+		{
+			description: "group param types",
+			checkers:    []NodeChecker{mustNew(NewGroupParamTypesChecker(nil))},
+			input: `package test
+
+func foo(a string, b string, c string, d int) {}
+`,
+			expected: `package test
+
+func foo(a, b, c string, d int) {}
+`,
+		},
+		{
+			description: "exported ident doc",
+			checkers:    []NodeChecker{mustNew(NewExportedIdentDocChecker(nil))},
+			input: `package test
+
+func Foo() {}
+
+var Bar, Baz int
+
+type Qux struct {
+	Quux int
+}
+`,
+			expected: `package test
+
+// Foo ...
+func Foo() {}
+
+// Bar ...
+var Bar, Baz int
+
+// Qux ...
+type Qux struct {
+	// Quux ...
+	Quux int
+}
+`,
+		},
+		{
+			description: "overlapping edits",
+			checkers: []NodeChecker{
+				mustNew(NewExportedIdentDocChecker(nil)),
+				mustNew(NewGroupParamTypesChecker(nil)),
+			},
+			input: `package test
+
+func Foo(a string, b string) {}
+`,
+			expected: `package test
+
+// Foo ...
+func Foo(a, b string) {}
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			checker := NewFileChecker()
+			for _, c := range test.checkers {
+				checker.Register(c)
+			}
+
+			file := ParseFileContent(test.input)
+			var report Report
+			checker.Check(file, test.input, &report)
+
+			fixReport, err := Fix(file, test.input, &report)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, fixReport.Content)
+
+			var remaining []string
+			for _, err := range fixReport.Remaining {
+				remaining = append(remaining, err.Message)
+			}
+			assert.Equal(t, test.remaining, remaining)
+		})
+	}
+}
@@ -3,19 +3,20 @@ package checker
 import (
 	"fmt"
 	"go/ast"
-
-	"github.com/uber-go/mapdecode"
 )
 
 func init() {
-	must(Register("func_cyclo", NewFuncCycloChecker))
+	must(Register(funcCycloSlug, NewFuncCycloChecker))
+	must(RegisterConfig(funcCycloSlug, FuncCycloConfig{}))
 }
 
+const funcCycloSlug = "func_cyclo"
+
 // FuncCycloConfig describes the configuration of a FuncCycloChecker.
 type FuncCycloConfig struct {
 
 	// Max is the maximum cyclomatic complexity of a func.
-	Max int `mapdecode:"max"`
+	Max int `mapdecode:"max" doc:"the maximum cyclomatic complexity of a func"`
 }
 
 // FuncCycloChecker checks that funcs are within specific cyclomatic complexity.
@@ -24,14 +25,25 @@ type FuncCycloChecker struct {
 }
 
 // NewFuncCycloChecker constructs a FuncCycloChecker.
-func NewFuncCycloChecker(configData interface{}) NodeChecker {
+func NewFuncCycloChecker(configData interface{}) (NodeChecker, error) {
 	var config FuncCycloConfig
-	if err := mapdecode.Decode(&config, configData); err != nil {
-		return nil
+	decodeErr := decodeConfig(funcCycloSlug, configData, &config)
+	negativeErr := checkNotNegative(funcCycloSlug, map[string]int{
+		"max": config.Max,
+	})
+	if err := mergeConfigErrors(decodeErr, negativeErr); err != nil {
+		return nil, err
 	}
 
 	return &FuncCycloChecker{
 		max: config.Max,
+	}, nil
+}
+
+// Config implements the ConfiguredChecker interface.
+func (c *FuncCycloChecker) Config() interface{} {
+	return FuncCycloConfig{
+		Max: c.max,
 	}
 }
 
@@ -63,17 +75,26 @@ func (c *FuncCycloChecker) Check(
 	report *Report) {
 
 	funcDecl := node.(*ast.FuncDecl)
-	var complexity complexityComputer
-	ast.Walk(&complexity, funcDecl)
-	if int(complexity) > c.max {
+	complexity := FuncCyclo(funcDecl)
+	if complexity > c.max {
 		report.Errors = append(report.Errors, Error{
-			Pos: funcDecl.Pos(),
+			Slug: funcCycloSlug,
+			Pos:  funcDecl.Pos(),
+			End:  funcDecl.Type.End(),
 			Message: fmt.Sprintf("func %s has cyclomatic complexity %d, max is %d",
 				funcDecl.Name.Name, complexity, c.max),
 		})
 	}
 }
 
+// FuncCyclo returns the cyclomatic complexity of `funcDecl`.
+func FuncCyclo(funcDecl *ast.FuncDecl) int {
+	var complexity complexityComputer
+	ast.Walk(&complexity, funcDecl)
+
+	return int(complexity)
+}
+
 type complexityComputer int
 
 // Visit implements the ast.Visitor interface.
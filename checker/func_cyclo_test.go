@@ -1,6 +1,7 @@
 package checker_test
 
 import (
+	"go/ast"
 	"testing"
 
 	. "github.com/s2gatev/lingo/checker"
@@ -83,7 +84,9 @@ func TestFuncCycloChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_cyclo",
 						Pos:     18,
+						End:     37,
 						Message: "func Bar has cyclomatic complexity 5, max is 3",
 					},
 				},
@@ -119,7 +122,9 @@ func TestFuncCycloChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_cyclo",
 						Pos:     40,
+						End:     68,
 						Message: "func Bar has cyclomatic complexity 5, max is 3",
 					},
 				},
@@ -148,7 +153,9 @@ func TestFuncCycloChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_cyclo",
 						Pos:     40,
+						End:     68,
 						Message: "func Bar has cyclomatic complexity 4, max is 3",
 					},
 				},
@@ -159,9 +166,9 @@ func TestFuncCycloChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewFuncCycloChecker(FuncCycloConfig{
+			checker.Register(mustNew(NewFuncCycloChecker(FuncCycloConfig{
 				Max: test.max,
-			}))
+			})))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -170,3 +177,20 @@ func TestFuncCycloChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestFuncCyclo(t *testing.T) {
+	file := ParseFileContent(`package test
+
+	func foo(x int) int {
+		for i := 0; i < x; i++ {
+			if i > 5 {
+				return i
+			}
+		}
+
+		return 0
+	}
+	`)
+
+	assert.Equal(t, 3, FuncCyclo(file.Decls[0].(*ast.FuncDecl)))
+}
@@ -3,19 +3,20 @@ package checker
 import (
 	"fmt"
 	"go/ast"
-
-	"github.com/uber-go/mapdecode"
 )
 
 func init() {
-	must(Register("func_params_count", NewFuncParamsCountChecker))
+	must(Register(funcParamsCountSlug, NewFuncParamsCountChecker))
+	must(RegisterConfig(funcParamsCountSlug, FuncParamsCountConfig{}))
 }
 
+const funcParamsCountSlug = "func_params_count"
+
 // FuncParamsCountConfig describes the configuration of a FuncParamsCountChecker.
 type FuncParamsCountConfig struct {
 
 	// Max is the maximum number of parameters of a func.
-	Max int `mapdecode:"max"`
+	Max int `mapdecode:"max" doc:"the maximum number of parameters of a func"`
 }
 
 // FuncParamsCountChecker checks that funcs have a limited number of parameters.
@@ -24,14 +25,25 @@ type FuncParamsCountChecker struct {
 }
 
 // NewFuncParamsCountChecker constructs a FuncParamsCountChecker.
-func NewFuncParamsCountChecker(configData interface{}) NodeChecker {
+func NewFuncParamsCountChecker(configData interface{}) (NodeChecker, error) {
 	var config FuncParamsCountConfig
-	if err := mapdecode.Decode(&config, configData); err != nil {
-		return nil
+	decodeErr := decodeConfig(funcParamsCountSlug, configData, &config)
+	negativeErr := checkNotNegative(funcParamsCountSlug, map[string]int{
+		"max": config.Max,
+	})
+	if err := mergeConfigErrors(decodeErr, negativeErr); err != nil {
+		return nil, err
 	}
 
 	return &FuncParamsCountChecker{
 		max: config.Max,
+	}, nil
+}
+
+// Config implements the ConfiguredChecker interface.
+func (c *FuncParamsCountChecker) Config() interface{} {
+	return FuncParamsCountConfig{
+		Max: c.max,
 	}
 }
 
@@ -63,20 +75,33 @@ func (c *FuncParamsCountChecker) Check(
 
 	funcType := node.(*ast.FuncType)
 
-	var paramsCount int
-	for _, param := range funcType.Params.List {
-		if len(param.Names) > 0 {
-			paramsCount += len(param.Names)
-		} else {
-			paramsCount += 1
-		}
-	}
-
+	paramsCount := FieldCount(funcType.Params)
 	if paramsCount > c.max {
 		report.Errors = append(report.Errors, Error{
-			Pos: funcType.Pos(),
+			Slug: funcParamsCountSlug,
+			Pos:  funcType.Pos(),
+			End:  funcType.Params.End(),
 			Message: fmt.Sprintf("func has %d params, max is %d",
 				paramsCount, c.max),
 		})
 	}
 }
+
+// FieldCount returns the number of fields in `fields` where every name of
+// a field with multiple names is counted separately.
+func FieldCount(fields *ast.FieldList) int {
+	if fields == nil {
+		return 0
+	}
+
+	var count int
+	for _, field := range fields.List {
+		if len(field.Names) > 0 {
+			count += len(field.Names)
+		} else {
+			count++
+		}
+	}
+
+	return count
+}
@@ -1,6 +1,7 @@
 package checker_test
 
 import (
+	"go/ast"
 	"testing"
 
 	. "github.com/s2gatev/lingo/checker"
@@ -60,7 +61,9 @@ func TestFuncParamsCountChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_params_count",
 						Pos:     18,
+						End:     57,
 						Message: "func has 4 params, max is 3",
 					},
 				},
@@ -78,7 +81,9 @@ func TestFuncParamsCountChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_params_count",
 						Pos:     18,
+						End:     56,
 						Message: "func has 4 params, max is 3",
 					},
 				},
@@ -96,7 +101,9 @@ func TestFuncParamsCountChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_params_count",
 						Pos:     18,
+						End:     57,
 						Message: "func has 4 params, max is 3",
 					},
 				},
@@ -107,9 +114,9 @@ func TestFuncParamsCountChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewFuncParamsCountChecker(FuncParamsCountConfig{
+			checker.Register(mustNew(NewFuncParamsCountChecker(FuncParamsCountConfig{
 				Max: test.max,
-			}))
+			})))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -118,3 +125,20 @@ func TestFuncParamsCountChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestFieldCount(t *testing.T) {
+	file := ParseFileContent(`package test
+
+	func foo(a, b int, c string) (int, error) {}
+
+	func bar() {}
+	`)
+
+	foo := file.Decls[0].(*ast.FuncDecl)
+	assert.Equal(t, 3, FieldCount(foo.Type.Params))
+	assert.Equal(t, 2, FieldCount(foo.Type.Results))
+
+	bar := file.Decls[1].(*ast.FuncDecl)
+	assert.Equal(t, 0, FieldCount(bar.Type.Params))
+	assert.Equal(t, 0, FieldCount(bar.Type.Results))
+}
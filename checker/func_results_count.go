@@ -3,19 +3,20 @@ package checker
 import (
 	"fmt"
 	"go/ast"
-
-	"github.com/uber-go/mapdecode"
 )
 
 func init() {
-	must(Register("func_results_count", NewFuncResultsCountChecker))
+	must(Register(funcResultsCountSlug, NewFuncResultsCountChecker))
+	must(RegisterConfig(funcResultsCountSlug, FuncResultsCountConfig{}))
 }
 
+const funcResultsCountSlug = "func_results_count"
+
 // FuncResultsCountConfig describes the configuration of a FuncResultsCountChecker.
 type FuncResultsCountConfig struct {
 
 	// Max is the maximum number of results of a func.
-	Max int `mapdecode:"max"`
+	Max int `mapdecode:"max" doc:"the maximum number of results of a func"`
 }
 
 // FuncResultsCountChecker checks that funcs have a limited number of results.
@@ -24,14 +25,25 @@ type FuncResultsCountChecker struct {
 }
 
 // NewFuncResultsCountChecker constructs a FuncResultsCountChecker.
-func NewFuncResultsCountChecker(configData interface{}) NodeChecker {
+func NewFuncResultsCountChecker(configData interface{}) (NodeChecker, error) {
 	var config FuncResultsCountConfig
-	if err := mapdecode.Decode(&config, configData); err != nil {
-		return nil
+	decodeErr := decodeConfig(funcResultsCountSlug, configData, &config)
+	negativeErr := checkNotNegative(funcResultsCountSlug, map[string]int{
+		"max": config.Max,
+	})
+	if err := mergeConfigErrors(decodeErr, negativeErr); err != nil {
+		return nil, err
 	}
 
 	return &FuncResultsCountChecker{
 		max: config.Max,
+	}, nil
+}
+
+// Config implements the ConfiguredChecker interface.
+func (c *FuncResultsCountChecker) Config() interface{} {
+	return FuncResultsCountConfig{
+		Max: c.max,
 	}
 }
 
@@ -67,18 +79,12 @@ func (c *FuncResultsCountChecker) Check(
 		return
 	}
 
-	var resultsCount int
-	for _, result := range funcType.Results.List {
-		if len(result.Names) > 0 {
-			resultsCount += len(result.Names)
-		} else {
-			resultsCount += 1
-		}
-	}
-
+	resultsCount := FieldCount(funcType.Results)
 	if resultsCount > c.max {
 		report.Errors = append(report.Errors, Error{
-			Pos: funcType.Pos(),
+			Slug: funcResultsCountSlug,
+			Pos:  funcType.Pos(),
+			End:  funcType.End(),
 			Message: fmt.Sprintf("func has %d results, max is %d",
 				resultsCount, c.max),
 		})
@@ -59,7 +59,9 @@ func TestFuncResultsCountChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_results_count",
 						Pos:     18,
+						End:     55,
 						Message: "func has 4 results, max is 3",
 					},
 				},
@@ -77,7 +79,9 @@ func TestFuncResultsCountChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "func_results_count",
 						Pos:     18,
+						End:     50,
 						Message: "func has 4 results, max is 3",
 					},
 				},
@@ -88,9 +92,9 @@ func TestFuncResultsCountChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewFuncResultsCountChecker(FuncResultsCountConfig{
+			checker.Register(mustNew(NewFuncResultsCountChecker(FuncResultsCountConfig{
 				Max: test.max,
-			}))
+			})))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -7,16 +7,22 @@ import (
 )
 
 func init() {
-	must(Register("group_param_types", NewGroupParamTypesChecker))
+	must(Register(groupParamTypesSlug, NewGroupParamTypesChecker))
 }
 
+const groupParamTypesSlug = "group_param_types"
+
 // GroupParamTypesChecker checks that func parameters are grouped by
 // type.
 type GroupParamTypesChecker struct{}
 
 // NewGroupParamTypesChecker constructs a GroupParamTypesChecker.
-func NewGroupParamTypesChecker(configData interface{}) NodeChecker {
-	return &GroupParamTypesChecker{}
+func NewGroupParamTypesChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(groupParamTypesSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &GroupParamTypesChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -52,19 +58,44 @@ func (c *GroupParamTypesChecker) Check(
 
 	funcType := node.(*ast.FuncType)
 
+	var prevParam *ast.Field
 	var prevType string
 	for _, param := range funcType.Params.List {
 		curType := typeName(param.Type)
 		if curType == prevType {
 			report.Errors = append(report.Errors, Error{
+				Slug:    groupParamTypesSlug,
 				Pos:     node.Pos(),
+				End:     funcType.Params.End(),
 				Message: `params should be grouped by type`,
+				Edits:   c.mergeParams(prevParam, param),
 			})
 		}
+		prevParam = param
 		prevType = curType
 	}
 }
 
+// mergeParams returns the edits which merge `param` into the group of
+// `prevParam` by removing the type of `prevParam`.
+func (c *GroupParamTypesChecker) mergeParams(prevParam, param *ast.Field) []Edit {
+	if prevParam == nil || typeName(param.Type) == "" {
+		return nil
+	}
+
+	if len(prevParam.Names) == 0 || len(param.Names) == 0 {
+		return nil
+	}
+
+	lastName := prevParam.Names[len(prevParam.Names)-1]
+	return []Edit{
+		{
+			Pos: lastName.End(),
+			End: prevParam.Type.End(),
+		},
+	}
+}
+
 func typeName(expr ast.Expr) string {
 	switch expr := expr.(type) {
 	case *ast.BasicLit:
@@ -109,8 +140,10 @@ func funcTypeName(funcType *ast.FuncType) string {
 	params := strings.Join(paramTypes, ", ")
 
 	var resultTypes []string
-	for _, result := range funcType.Results.List {
-		resultTypes = append(resultTypes, typeName(result.Type))
+	if funcType.Results != nil {
+		for _, result := range funcType.Results.List {
+			resultTypes = append(resultTypes, typeName(result.Type))
+		}
 	}
 	results := strings.Join(resultTypes, ", ")
 	if len(resultTypes) > 0 {
@@ -69,8 +69,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     52,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 41,
+							},
+						},
 					},
 				},
 			},
@@ -85,8 +93,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     61,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 43,
+								End: 50,
+							},
+						},
 					},
 				},
 			},
@@ -101,8 +117,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     54,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 42,
+							},
+						},
 					},
 				},
 			},
@@ -126,8 +150,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     52,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 41,
+							},
+						},
 					},
 				},
 			},
@@ -142,8 +174,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     50,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 40,
+							},
+						},
 					},
 				},
 			},
@@ -158,8 +198,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     68,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 49,
+							},
+						},
 					},
 				},
 			},
@@ -174,8 +222,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     54,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 42,
+							},
+						},
 					},
 				},
 			},
@@ -190,8 +246,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     60,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 45,
+							},
+						},
 					},
 				},
 			},
@@ -215,8 +279,16 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "group_param_types",
 						Pos:     24,
+						End:     56,
 						Message: `params should be grouped by type`,
+						Edits: []Edit{
+							{
+								Pos: 34,
+								End: 43,
+							},
+						},
 					},
 				},
 			},
@@ -248,12 +320,21 @@ func TestGroupParamTypesChecker(t *testing.T) {
 			`,
 			expected: Report{},
 		},
+		{
+			description: "func literal, different func params without results",
+			input: `
+				package test
+
+				var foo = func(a func(int), b func(string)) {}
+			`,
+			expected: Report{},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewGroupParamTypesChecker(nil))
+			checker.Register(mustNew(NewGroupParamTypesChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
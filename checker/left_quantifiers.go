@@ -1,15 +1,19 @@
 package checker
 
 import (
+	"bytes"
 	"fmt"
 	"go/ast"
+	"go/printer"
 	"go/token"
 )
 
 func init() {
-	must(Register("left_quantifiers", NewLeftQuantifiersChecker))
+	must(Register(leftQuantifiersSlug, NewLeftQuantifiersChecker))
 }
 
+const leftQuantifiersSlug = "left_quantifiers"
+
 // LeftQuantifiersChecker checks that when a basic literal appears in a binary
 // expression it is the left operand.
 type LeftQuantifiersChecker struct {
@@ -17,10 +21,14 @@ type LeftQuantifiersChecker struct {
 }
 
 // NewLeftQuantifiersChecker constructs a LeftQuantifiersChecker.
-func NewLeftQuantifiersChecker(configData interface{}) NodeChecker {
+func NewLeftQuantifiersChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(leftQuantifiersSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
 	return &LeftQuantifiersChecker{
 		assessed: map[token.Pos]struct{}{},
-	}
+	}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -63,8 +71,11 @@ func (c *LeftQuantifiersChecker) Check(
 	assessment := c.assess(node)
 	if _, ok := validAssessments[assessment]; !ok {
 		report.Errors = append(report.Errors, Error{
+			Slug:    leftQuantifiersSlug,
 			Pos:     node.Pos(),
+			End:     node.End(),
 			Message: fmt.Sprintf("the left operand should be a basic literal"),
+			Edits:   c.swapOperands(expr),
 		})
 	}
 }
@@ -128,6 +139,44 @@ func (c *LeftQuantifiersChecker) assessBinaryExpr(
 	}
 }
 
+// swapOperands returns the edits which swap the operands of `expr` when its
+// right operand is a number literal.
+func (c *LeftQuantifiersChecker) swapOperands(expr *ast.BinaryExpr) []Edit {
+	lit, ok := ast.Unparen(expr.Y).(*ast.BasicLit)
+	if !ok || lit.Kind == token.STRING {
+		return nil
+	}
+
+	// Binary operators are left-associative so a left operand with another
+	// operator of the same precedence needs parentheses on the right.
+	x := exprString(expr.X)
+	if operand, ok := expr.X.(*ast.BinaryExpr); ok &&
+		operand.Op != expr.Op &&
+		operand.Op.Precedence() == expr.Op.Precedence() {
+
+		x = "(" + x + ")"
+	}
+
+	return []Edit{
+		{
+			Pos:     expr.X.Pos(),
+			End:     expr.X.End(),
+			NewText: exprString(expr.Y),
+		},
+		{
+			Pos:     expr.Y.Pos(),
+			End:     expr.Y.End(),
+			NewText: x,
+		},
+	}
+}
+
+func exprString(expr ast.Expr) string {
+	var buffer bytes.Buffer
+	printer.Fprint(&buffer, token.NewFileSet(), expr)
+	return buffer.String()
+}
+
 // assessment indicates the type of an expression.
 type assessment uint
 
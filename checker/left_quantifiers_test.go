@@ -46,8 +46,22 @@ func TestLeftQuantifiers(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "left_quantifiers",
 						Pos:     58,
+						End:     73,
 						Message: "the left operand should be a basic literal",
+						Edits: []Edit{
+							{
+								Pos:     58,
+								End:     69,
+								NewText: "5",
+							},
+							{
+								Pos:     72,
+								End:     73,
+								NewText: "time.Second",
+							},
+						},
 					},
 				},
 			},
@@ -86,8 +100,22 @@ func TestLeftQuantifiers(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "left_quantifiers",
 						Pos:     58,
+						End:     77,
 						Message: "the left operand should be a basic literal",
+						Edits: []Edit{
+							{
+								Pos:     58,
+								End:     73,
+								NewText: "3",
+							},
+							{
+								Pos:     76,
+								End:     77,
+								NewText: "2 * time.Second",
+							},
+						},
 					},
 				},
 			},
@@ -105,7 +133,9 @@ func TestLeftQuantifiers(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "left_quantifiers",
 						Pos:     58,
+						End:     96,
 						Message: "the left operand should be a basic literal",
 					},
 				},
@@ -131,8 +161,22 @@ func TestLeftQuantifiers(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "left_quantifiers",
 						Pos:     58,
+						End:     63,
 						Message: "the left operand should be a basic literal",
+						Edits: []Edit{
+							{
+								Pos:     58,
+								End:     59,
+								NewText: "1",
+							},
+							{
+								Pos:     62,
+								End:     63,
+								NewText: "i",
+							},
+						},
 					},
 				},
 			},
@@ -142,7 +186,7 @@ func TestLeftQuantifiers(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewLeftQuantifiersChecker(nil))
+			checker.Register(mustNew(NewLeftQuantifiersChecker(nil)))
 
 			file := ParseFileContent(fmt.Sprintf(input, test.expression))
 			var report Report
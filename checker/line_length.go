@@ -6,24 +6,29 @@ import (
 	"go/ast"
 	"go/token"
 	"strings"
-
-	"github.com/uber-go/mapdecode"
 )
 
 func init() {
-	must(Register("line_length", NewLineLengthChecker))
+	must(Register(lineLengthSlug, NewLineLengthChecker))
+	must(RegisterConfig(lineLengthSlug, LineLengthConfig{}))
 }
 
+const lineLengthSlug = "line_length"
+
 // LineLengthConfig describes the configuration of a LineLengthChecker.
 type LineLengthConfig struct {
 
 	// MaxLength is the maximum number of characters permitted on a single line.
-	MaxLength int `mapdecode:"max_length"`
+	MaxLength int `mapdecode:"max_length" doc:"the maximum length of a line"`
 
 	// TabWidth is the number of characters equivalent to a single tab.
-	TabWidth int `mapdecode:"tab_width"`
+	TabWidth int `mapdecode:"tab_width" doc:"the length of a tab, 4 by default"`
 }
 
+// defaultTabWidth is the number of characters equivalent to a single tab
+// if the tab width is not configured.
+const defaultTabWidth = 4
+
 // LineLengthChecker checks that code lines are within specific length limits.
 type LineLengthChecker struct {
 	maxLength int
@@ -31,15 +36,32 @@ type LineLengthChecker struct {
 }
 
 // NewLineLengthChecker constructs a LineLengthChecker.
-func NewLineLengthChecker(configData interface{}) NodeChecker {
-	var config LineLengthConfig
-	if err := mapdecode.Decode(&config, configData); err != nil {
-		return nil
+func NewLineLengthChecker(configData interface{}) (NodeChecker, error) {
+	config := LineLengthConfig{
+		TabWidth: defaultTabWidth,
+	}
+	decodeErr := decodeConfig(lineLengthSlug, configData, &config)
+	negativeErr := checkNotNegative(lineLengthSlug, map[string]int{
+		"max_length": config.MaxLength,
+	})
+	positiveErr := checkPositive(lineLengthSlug, map[string]int{
+		"tab_width": config.TabWidth,
+	})
+	if err := mergeConfigErrors(decodeErr, negativeErr, positiveErr); err != nil {
+		return nil, err
 	}
 
 	return &LineLengthChecker{
 		maxLength: config.MaxLength,
 		tabWidth:  config.TabWidth,
+	}, nil
+}
+
+// Config implements the ConfiguredChecker interface.
+func (c *LineLengthChecker) Config() interface{} {
+	return LineLengthConfig{
+		MaxLength: c.maxLength,
+		TabWidth:  c.tabWidth,
 	}
 }
 
@@ -69,18 +91,17 @@ func (c *LineLengthChecker) Check(
 	content string,
 	report *Report) {
 
-	tabAsSpaces := strings.Repeat(" ", c.tabWidth)
-
 	pos := int(node.Pos())
 	scanner := bufio.NewScanner(strings.NewReader(content))
 	for scanner.Scan() {
 		line := scanner.Text()
 		length := len(line) + 1
 
-		line = strings.Replace(line, "\t", tabAsSpaces, -1)
-		if len(line) > c.maxLength {
+		if LineLength(line, c.tabWidth) > c.maxLength {
 			report.Errors = append(report.Errors, Error{
+				Slug:    lineLengthSlug,
 				Pos:     token.Pos(pos),
+				End:     token.Pos(pos + length - 1),
 				Message: fmt.Sprintf("line is too long"),
 			})
 		}
@@ -88,3 +109,9 @@ func (c *LineLengthChecker) Check(
 		pos += length
 	}
 }
+
+// LineLength returns the number of characters on `line` where every tab is
+// counted as `tabWidth` characters.
+func LineLength(line string, tabWidth int) int {
+	return len(line) + strings.Count(line, "\t")*(tabWidth-1)
+}
@@ -15,10 +15,10 @@ func TestLineLengthChecker(t *testing.T) {
 	func TestFooBarFunctionVeryLong(a int, b int, c int, d int) (error, float64) {}`
 
 	checker := NewFileChecker()
-	checker.Register(NewLineLengthChecker(&LineLengthConfig{
+	checker.Register(mustNew(NewLineLengthChecker(&LineLengthConfig{
 		MaxLength: 80,
 		TabWidth:  4,
-	}))
+	})))
 
 	fileSet := token.NewFileSet()
 	file := ParseFileContentInSet(fileSet, input)
@@ -30,7 +30,9 @@ func TestLineLengthChecker(t *testing.T) {
 		Report{
 			Errors: []Error{
 				{
+					Slug:    "line_length",
 					Pos:     14,
+					End:     94,
 					Message: "line is too long",
 				},
 			},
@@ -38,3 +40,37 @@ func TestLineLengthChecker(t *testing.T) {
 		report)
 	assert.Equal(t, 3, fileSet.Position(report.Errors[0].Pos).Line)
 }
+
+func TestLineLength(t *testing.T) {
+	tests := []struct {
+		description string
+		line        string
+		tabWidth    int
+		expected    int
+	}{
+		{
+			description: "no tabs",
+			line:        "foo := 1",
+			tabWidth:    4,
+			expected:    8,
+		},
+		{
+			description: "tabs",
+			line:        "\t\tfoo := 1",
+			tabWidth:    4,
+			expected:    16,
+		},
+		{
+			description: "zero tab width",
+			line:        "\tfoo := 1",
+			tabWidth:    0,
+			expected:    8,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.expected, LineLength(test.line, test.tabWidth))
+		})
+	}
+}
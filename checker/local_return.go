@@ -3,19 +3,26 @@ package checker
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 )
 
 func init() {
-	must(Register("local_return", NewLocalReturnChecker))
+	must(Register(localReturnSlug, NewLocalReturnChecker))
 }
 
+const localReturnSlug = "local_return"
+
 // LocalReturnChecker checks that exported funcs return exported
 // (and internal) types only.
 type LocalReturnChecker struct{}
 
 // NewLocalReturnChecker constructs a LocalReturnChecker.
-func NewLocalReturnChecker(configData interface{}) NodeChecker {
-	return &LocalReturnChecker{}
+func NewLocalReturnChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(localReturnSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &LocalReturnChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -41,7 +48,8 @@ func (c *LocalReturnChecker) Examples() []Example {
 // Register implements the NodeChecker interface.
 func (c *LocalReturnChecker) Register(fc *FileChecker) {
 	fc.On(&ast.FuncDecl{}, c)
-	fc.On(&ast.Field{}, c)
+	fc.On(&ast.StructType{}, c)
+	fc.On(&ast.InterfaceType{}, c)
 }
 
 // Check implements the NodeChecker interface.
@@ -50,15 +58,42 @@ func (c *LocalReturnChecker) Check(
 	content string,
 	report *Report) {
 
+	c.check(node, nil, report)
+}
+
+// CheckTyped implements the TypedNodeChecker interface.
+func (c *LocalReturnChecker) CheckTyped(
+	node ast.Node,
+	info *TypeInfo,
+	report *Report) {
+
+	c.check(node, info, report)
+}
+
+func (c *LocalReturnChecker) check(
+	node ast.Node,
+	info *TypeInfo,
+	report *Report) {
+
 	switch node := node.(type) {
 	case *ast.FuncDecl:
-		c.checkFuncDecl(node, report)
-	case *ast.Field:
-		c.checkField(node, report)
+		c.checkFuncDecl(node, info, report)
+	case *ast.StructType:
+		for _, field := range node.Fields.List {
+			c.checkField(field, info, report)
+		}
+	case *ast.InterfaceType:
+		for _, method := range node.Methods.List {
+			c.checkField(method, info, report)
+		}
 	}
 }
 
-func (c *LocalReturnChecker) checkFuncDecl(decl *ast.FuncDecl, report *Report) {
+func (c *LocalReturnChecker) checkFuncDecl(
+	decl *ast.FuncDecl,
+	info *TypeInfo,
+	report *Report) {
+
 	if !decl.Name.IsExported() {
 		return
 	}
@@ -68,11 +103,22 @@ func (c *LocalReturnChecker) checkFuncDecl(decl *ast.FuncDecl, report *Report) {
 	}
 
 	for _, result := range decl.Type.Results.List {
-		c.checkExpr(decl.Name.Name, result.Type, report)
+		if ident := c.localType(result.Type, info); ident != nil {
+			report.Errors = append(report.Errors, Error{
+				Slug:    localReturnSlug,
+				Pos:     ident.Pos(),
+				End:     ident.End(),
+				Message: fmt.Sprintf(localReturnErrMsg, decl.Name.Name, ident.Name),
+			})
+		}
 	}
 }
 
-func (c *LocalReturnChecker) checkField(field *ast.Field, report *Report) {
+func (c *LocalReturnChecker) checkField(
+	field *ast.Field,
+	info *TypeInfo,
+	report *Report) {
+
 	typ, ok := field.Type.(*ast.FuncType)
 	if !ok {
 		return
@@ -88,43 +134,47 @@ func (c *LocalReturnChecker) checkField(field *ast.Field, report *Report) {
 		}
 
 		for _, result := range typ.Results.List {
-			c.checkExpr(name.Name, result.Type, report)
+			if ident := c.localType(result.Type, info); ident != nil {
+				report.Errors = append(report.Errors, Error{
+					Slug:    localReturnSlug,
+					Pos:     ident.Pos(),
+					End:     ident.End(),
+					Message: fmt.Sprintf(localReturnErrMsg, name.Name, ident.Name),
+				})
+			}
 		}
 	}
 }
 
-func (c *LocalReturnChecker) checkExpr(
-	funcName string,
-	expr ast.Expr,
-	report *Report) {
-
+// localType returns the identifier of the local type referenced by `expr`
+// or nil if there is no such type.
+func (c *LocalReturnChecker) localType(expr ast.Expr, info *TypeInfo) *ast.Ident {
 	switch expr := expr.(type) {
 	case *ast.Ident:
-		c.checkIdent(funcName, expr, report)
+		if c.isPredeclared(expr, info) || expr.IsExported() {
+			return nil
+		}
+		return expr
 	case *ast.ChanType:
-		c.checkExpr(funcName, expr.Value, report)
+		return c.localType(expr.Value, info)
 	case *ast.ArrayType:
-		c.checkExpr(funcName, expr.Elt, report)
+		return c.localType(expr.Elt, info)
+	default:
+		return nil
 	}
 }
 
-func (c *LocalReturnChecker) checkIdent(
-	funcName string,
-	ident *ast.Ident,
-	report *Report) {
-
-	if _, ok := internalTypes[ident.Name]; ok {
-		return
-	}
-
-	if ident.IsExported() {
-		return
+// isPredeclared reports whether `ident` refers to a predeclared type.
+// Without type information only the names of the predeclared types are
+// considered.
+func (c *LocalReturnChecker) isPredeclared(ident *ast.Ident, info *TypeInfo) bool {
+	if info == nil {
+		_, ok := internalTypes[ident.Name]
+		return ok
 	}
 
-	report.Errors = append(report.Errors, Error{
-		Pos:     ident.Pos(),
-		Message: fmt.Sprintf(localReturnErrMsg, funcName, ident.Name),
-	})
+	obj, ok := info.Info.Uses[ident]
+	return ok && obj.Parent() == types.Universe
 }
 
 const localReturnErrMsg = "exported func '%s' cannot return value " +
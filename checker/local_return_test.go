@@ -80,11 +80,15 @@ func TestLocalReturnChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "local_return",
 						Pos:     36,
+						End:     39,
 						Message: "exported func 'Foo1' cannot return value of local type 'bar'",
 					},
 					{
+						Slug:    "local_return",
 						Pos:     69,
+						End:     72,
 						Message: "exported func 'Foo2' cannot return value of local type 'bar'",
 					},
 				},
@@ -102,19 +106,27 @@ func TestLocalReturnChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "local_return",
 						Pos:     37,
+						End:     41,
 						Message: "exported func 'Foo1' cannot return value of local type 'bar1'",
 					},
 					{
+						Slug:    "local_return",
 						Pos:     49,
+						End:     53,
 						Message: "exported func 'Foo1' cannot return value of local type 'bar3'",
 					},
 					{
+						Slug:    "local_return",
 						Pos:     85,
+						End:     89,
 						Message: "exported func 'Foo2' cannot return value of local type 'bar1'",
 					},
 					{
+						Slug:    "local_return",
 						Pos:     97,
+						End:     101,
 						Message: "exported func 'Foo2' cannot return value of local type 'bar3'",
 					},
 				},
@@ -145,11 +157,15 @@ func TestLocalReturnChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "local_return",
 						Pos:     41,
+						End:     44,
 						Message: "exported func 'Foo1' cannot return value of local type 'bar'",
 					},
 					{
+						Slug:    "local_return",
 						Pos:     79,
+						End:     82,
 						Message: "exported func 'Foo2' cannot return value of local type 'bar'",
 					},
 				},
@@ -167,11 +183,15 @@ func TestLocalReturnChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "local_return",
 						Pos:     38,
+						End:     41,
 						Message: "exported func 'Foo1' cannot return value of local type 'bar'",
 					},
 					{
+						Slug:    "local_return",
 						Pos:     73,
+						End:     76,
 						Message: "exported func 'Foo2' cannot return value of local type 'bar'",
 					},
 				},
@@ -189,11 +209,15 @@ func TestLocalReturnChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "local_return",
 						Pos:     40,
+						End:     43,
 						Message: "exported func 'Foo1' cannot return value of local type 'bar'",
 					},
 					{
+						Slug:    "local_return",
 						Pos:     77,
+						End:     80,
 						Message: "exported func 'Foo2' cannot return value of local type 'bar'",
 					},
 				},
@@ -237,7 +261,9 @@ func TestLocalReturnChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "local_return",
 						Pos:     59,
+						End:     62,
 						Message: "exported func 'Foo' cannot return value of local type 'bar'",
 					},
 				},
@@ -287,7 +313,7 @@ func TestLocalReturnChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewLocalReturnChecker(nil))
+			checker.Register(mustNew(NewLocalReturnChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -296,3 +322,57 @@ func TestLocalReturnChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalReturnCheckerTyped(t *testing.T) {
+	type test struct {
+		description string
+		input       string
+		expected    Report
+	}
+
+	tests := []test{
+		{
+			description: "exported func, predeclared returns",
+			input: `
+				package test
+
+				func Foo() (rune, uintptr) {}
+			`,
+			expected: Report{
+				Errors: nil,
+			},
+		},
+		{
+			description: "exported func, local type shadowing predeclared type",
+			input: `
+				package test
+
+				type string struct{}
+
+				func Foo() string {}
+			`,
+			expected: Report{
+				Errors: []Error{
+					{
+						Slug:    "local_return",
+						Pos:     61,
+						End:     67,
+						Message: "exported func 'Foo' cannot return value of local type 'string'",
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			checker := NewFileChecker()
+			checker.Register(mustNew(NewLocalReturnChecker(nil)))
+
+			file, info := ParseTypedFileContent(test.input)
+			var report Report
+			checker.WithTypes(info).Check(file, "", &report)
+			assert.Equal(t, test.expected, report)
+		})
+	}
+}
@@ -3,22 +3,31 @@ package checker
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"regexp"
 )
 
 func init() {
-	must(Register("multi_word_ident_name", NewMultiWordIdentNameChecker))
+	must(Register(multiWordIdentNameSlug, NewMultiWordIdentNameChecker))
 }
 
+const multiWordIdentNameSlug = "multi_word_ident_name"
+
 // MultiWordIdentNameChecker checks the correctness of type names.
 // Correct type names adhere to the following rules:
 // * PascalCase for exported types.
 // * camelCase for non-exported types.
+// Identifiers are checked only where they are declared, so names declared
+// in other packages are not reported.
 type MultiWordIdentNameChecker struct{}
 
 // NewMultiWordIdentNameChecker constructs a MultiWordIdentNameChecker.
-func NewMultiWordIdentNameChecker(configData interface{}) NodeChecker {
-	return &MultiWordIdentNameChecker{}
+func NewMultiWordIdentNameChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(multiWordIdentNameSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &MultiWordIdentNameChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -47,22 +56,80 @@ func (c *MultiWordIdentNameChecker) Examples() []Example {
 
 // Register implements the NodeChecker interface.
 func (c *MultiWordIdentNameChecker) Register(fc *FileChecker) {
-	fc.On(&ast.Ident{}, c)
+	fc.On(&ast.ImportSpec{}, c)
+	fc.On(&ast.TypeSpec{}, c)
+	fc.On(&ast.ValueSpec{}, c)
+	fc.On(&ast.FuncDecl{}, c)
+	fc.On(&ast.Field{}, c)
+	fc.On(&ast.AssignStmt{}, c)
+	fc.On(&ast.RangeStmt{}, c)
 }
 
-// Check implements the NodeChecker interface.
+// Check implements the NodeChecker interface. The package name is not
+// checked as test packages are conventionally named '*_test'.
 func (c *MultiWordIdentNameChecker) Check(
 	node ast.Node,
 	content string,
 	report *Report) {
 
-	name := node.(*ast.Ident).Name
+	switch node := node.(type) {
+	case *ast.ImportSpec:
+		if node.Name != nil && node.Name.Name != "." {
+			c.checkIdent(node.Name, report)
+		}
+	case *ast.TypeSpec:
+		c.checkIdent(node.Name, report)
+	case *ast.ValueSpec:
+		c.checkIdents(node.Names, report)
+	case *ast.FuncDecl:
+		c.checkIdent(node.Name, report)
+	case *ast.Field:
+		c.checkIdents(node.Names, report)
+	case *ast.AssignStmt:
+		if node.Tok == token.DEFINE {
+			c.checkDefined(node, node.Lhs, report)
+		}
+	case *ast.RangeStmt:
+		if node.Tok == token.DEFINE {
+			c.checkDefined(node, []ast.Expr{node.Key, node.Value}, report)
+		}
+	}
+}
+
+// checkDefined checks the identifiers in `exprs` which are declared by the
+// short variable declaration `decl`. Identifiers which are redeclared are
+// skipped as they are declared elsewhere.
+func (c *MultiWordIdentNameChecker) checkDefined(
+	decl ast.Node,
+	exprs []ast.Expr,
+	report *Report) {
+
+	for _, expr := range exprs {
+		ident, ok := expr.(*ast.Ident)
+		if !ok || ident.Obj != nil && ident.Obj.Decl != decl {
+			continue
+		}
+
+		c.checkIdent(ident, report)
+	}
+}
+
+func (c *MultiWordIdentNameChecker) checkIdents(idents []*ast.Ident, report *Report) {
+	for _, ident := range idents {
+		c.checkIdent(ident, report)
+	}
+}
+
+func (c *MultiWordIdentNameChecker) checkIdent(ident *ast.Ident, report *Report) {
+	name := ident.Name
 	if isCorrectIdentName(name) {
 		return
 	}
 
 	report.Errors = append(report.Errors, Error{
-		Pos:     node.Pos(),
+		Slug:    multiWordIdentNameSlug,
+		Pos:     ident.Pos(),
+		End:     ident.End(),
 		Message: fmt.Sprintf("name '%s' is not valid", name),
 	})
 }
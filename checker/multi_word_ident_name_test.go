@@ -40,15 +40,21 @@ func TestMultiWordIdentNameChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     81,
+						End:     89,
 						Message: "name 'foo_bar3' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     181,
+						End:     189,
 						Message: "name 'foo_bar6' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     285,
+						End:     293,
 						Message: "name 'foo_bar9' is not valid",
 					},
 				},
@@ -80,19 +86,27 @@ func TestMultiWordIdentNameChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     82,
+						End:     93,
 						Message: "name 'the_answer3' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     182,
+						End:     193,
 						Message: "name 'the_answer6' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     286,
+						End:     297,
 						Message: "name 'the_answer9' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     338,
+						End:     345,
 						Message: "name 'foo_bar' is not valid",
 					},
 				},
@@ -128,23 +142,33 @@ func TestMultiWordIdentNameChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     76,
+						End:     87,
 						Message: "name 'the_answer3' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     170,
+						End:     181,
 						Message: "name 'the_answer6' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     268,
+						End:     279,
 						Message: "name 'the_answer9' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     338,
+						End:     346,
 						Message: "name 'foo_bar6' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     391,
+						End:     399,
 						Message: "name 'foo_bar3' is not valid",
 					},
 				},
@@ -162,7 +186,9 @@ func TestMultiWordIdentNameChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     73,
+						End:     81,
 						Message: "name 'foo_bar3' is not valid",
 					},
 				},
@@ -182,7 +208,9 @@ func TestMultiWordIdentNameChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     123,
+						End:     131,
 						Message: "name 'foo_bar3' is not valid",
 					},
 				},
@@ -214,19 +242,27 @@ func TestMultiWordIdentNameChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     83,
+						End:     91,
 						Message: "name 'foo_bar3' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     120,
+						End:     128,
 						Message: "name 'foo_bar6' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     224,
+						End:     232,
 						Message: "name 'foo_bar9' is not valid",
 					},
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     264,
+						End:     273,
 						Message: "name 'foo_bar12' is not valid",
 					},
 				},
@@ -246,18 +282,78 @@ func TestMultiWordIdentNameChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "multi_word_ident_name",
 						Pos:     88,
+						End:     96,
 						Message: "name 'foo_bar3' is not valid",
 					},
 				},
 			},
 		},
+		{
+			description: "selector of another package",
+			input: `
+				package test
+
+				import "os"
+
+				func foo() {
+					file, err := os.OpenFile("foo", os.O_RDONLY, 0)
+					_, err = file, err
+					_, open_err := os.Open("bar")
+					_ = open_err
+				}
+			`,
+			expected: Report{
+				Errors: []Error{
+					{
+						Slug:    "multi_word_ident_name",
+						Pos:     139,
+						End:     147,
+						Message: "name 'open_err' is not valid",
+					},
+				},
+			},
+		},
+		{
+			description: "redeclared variable",
+			input: `
+				package test
+
+				func foo() {
+					var foo_bar int
+					foo_bar, baz := 1, 2
+					_, _ = foo_bar, baz
+				}
+			`,
+			expected: Report{
+				Errors: []Error{
+					{
+						Slug:    "multi_word_ident_name",
+						Pos:     46,
+						End:     53,
+						Message: "name 'foo_bar' is not valid",
+					},
+				},
+			},
+		},
+		{
+			description: "test package",
+			input: `
+				package foo_test
+
+				func TestFooBar() {}
+			`,
+			expected: Report{
+				Errors: nil,
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewMultiWordIdentNameChecker(nil))
+			checker.Register(mustNew(NewMultiWordIdentNameChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
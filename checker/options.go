@@ -0,0 +1,88 @@
+package checker
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Option describes a configuration option of a checker.
+type Option struct {
+
+	// Name is the key of the option in the checker configuration.
+	Name string `json:"name"`
+
+	// Type is the Go type of the option value.
+	Type string `json:"type"`
+
+	// Description is the description of the option.
+	Description string `json:"description"`
+}
+
+// Options returns the configuration options of the checker referenced by
+// `slug` in the order of the fields of its configuration struct. The name
+// of an option is taken from the mapdecode tag of its field and its
+// description from the doc tag.
+func Options(slug string) []Option {
+	configType, ok := configs[slug]
+	if !ok {
+		return nil
+	}
+
+	var options []Option
+	for i := 0; i < configType.NumField(); i++ {
+		field := configType.Field(i)
+		name := optionName(field)
+		if name == "" {
+			continue
+		}
+
+		options = append(options, Option{
+			Name:        name,
+			Type:        field.Type.String(),
+			Description: field.Tag.Get("doc"),
+		})
+	}
+
+	return options
+}
+
+// OptionValues returns the values of the options which `c` checks with
+// mapped by their names. Checkers which are not ConfiguredCheckers have no
+// option values.
+func OptionValues(c NodeChecker) map[string]interface{} {
+	configured, ok := c.(ConfiguredChecker)
+	if !ok {
+		return nil
+	}
+
+	config := reflect.ValueOf(configured.Config())
+	values := map[string]interface{}{}
+	for i := 0; i < config.NumField(); i++ {
+		name := optionName(config.Type().Field(i))
+		if name == "" {
+			continue
+		}
+
+		values[name] = config.Field(i).Interface()
+	}
+
+	return values
+}
+
+// optionName returns the name of the option of `field` or an empty string
+// if the field is not an option.
+func optionName(field reflect.StructField) string {
+	if field.PkgPath != "" {
+		return ""
+	}
+
+	name := strings.Split(field.Tag.Get("mapdecode"), ",")[0]
+	switch name {
+	case "-":
+		return ""
+	case "":
+		return field.Name
+	default:
+		return name
+	}
+}
@@ -0,0 +1,115 @@
+package checker_test
+
+import (
+	"testing"
+
+	. "github.com/s2gatev/lingo/checker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptions(t *testing.T) {
+	tests := []struct {
+		description string
+		slug        string
+		expected    []Option
+	}{
+		{
+			description: "checker with options",
+			slug:        "line_length",
+			expected: []Option{
+				{
+					Name:        "max_length",
+					Type:        "int",
+					Description: "the maximum length of a line",
+				},
+				{
+					Name:        "tab_width",
+					Type:        "int",
+					Description: "the length of a tab, 4 by default",
+				},
+			},
+		},
+		{
+			description: "checker without options",
+			slug:        "local_return",
+			expected:    nil,
+		},
+		{
+			description: "unknown checker",
+			slug:        "unknown",
+			expected:    nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.expected, Options(test.slug))
+		})
+	}
+}
+
+func TestOptionValues(t *testing.T) {
+	tests := []struct {
+		description string
+		slug        string
+		config      map[string]interface{}
+		expected    map[string]interface{}
+	}{
+		{
+			description: "configured options",
+			slug:        "line_length",
+			config: map[string]interface{}{
+				"max_length": 100,
+				"tab_width":  8,
+			},
+			expected: map[string]interface{}{
+				"max_length": 100,
+				"tab_width":  8,
+			},
+		},
+		{
+			description: "default options",
+			slug:        "line_length",
+			expected: map[string]interface{}{
+				"max_length": 0,
+				"tab_width":  4,
+			},
+		},
+		{
+			description: "checker without options",
+			slug:        "local_return",
+			expected:    nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			c, err := Get(test.slug, test.config)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, OptionValues(c))
+		})
+	}
+}
+
+func TestOptionsTags(t *testing.T) {
+	type config struct {
+		Renamed  string `mapdecode:"renamed,omitempty"`
+		Untagged []string
+		Ignored  bool `mapdecode:"-"`
+		private  int
+	}
+
+	err := RegisterConfig("options_tags", config{})
+	assert.NoError(t, err)
+	assert.Equal(t, []Option{
+		{
+			Name: "renamed",
+			Type: "string",
+		},
+		{
+			Name: "Untagged",
+			Type: "[]string",
+		},
+	}, Options("options_tags"))
+}
@@ -6,16 +6,22 @@ import (
 )
 
 func init() {
-	must(Register("pass_context_first", NewPassContextFirstChecker))
+	must(Register(passContextFirstSlug, NewPassContextFirstChecker))
 }
 
+const passContextFirstSlug = "pass_context_first"
+
 // PassContextFirstChecker checks that if a function declaration contains a context, it is
 // the first argument.
 type PassContextFirstChecker struct{}
 
 // NewPassContextFirstChecker constructs a PassContextFirstChecker.
-func NewPassContextFirstChecker(configData interface{}) NodeChecker {
-	return &PassContextFirstChecker{}
+func NewPassContextFirstChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(passContextFirstSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &PassContextFirstChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -49,7 +55,22 @@ func (c *PassContextFirstChecker) Check(
 	content string,
 	report *Report) {
 
-	decl := node.(*ast.FuncDecl)
+	c.check(node.(*ast.FuncDecl), nil, report)
+}
+
+// CheckTyped implements the TypedNodeChecker interface.
+func (c *PassContextFirstChecker) CheckTyped(
+	node ast.Node,
+	info *TypeInfo,
+	report *Report) {
+
+	c.check(node.(*ast.FuncDecl), info, report)
+}
+
+func (c *PassContextFirstChecker) check(
+	decl *ast.FuncDecl,
+	info *TypeInfo,
+	report *Report) {
 
 	if decl.Type.Params == nil {
 		return
@@ -62,26 +83,16 @@ func (c *PassContextFirstChecker) Check(
 
 	contextNotFirst := false
 	for _, param := range params[1:] {
-		selector, ok := param.Type.(*ast.SelectorExpr)
-		if !ok {
-			continue
-		}
-
-		ident, ok := selector.X.(*ast.Ident)
-		if !ok {
-			continue
-		}
-
-		// TODO: Handle the case where the context package is aliased in the scope of
-		// the file.
-		if ident.Name == "context" && selector.Sel.Name == "Context" {
+		if isQualifiedIdent(param.Type, "context.Context", info) {
 			contextNotFirst = true
 		}
 	}
 
 	if contextNotFirst {
 		report.Errors = append(report.Errors, Error{
-			Pos: node.Pos(),
+			Slug: passContextFirstSlug,
+			Pos:  decl.Pos(),
+			End:  decl.Type.End(),
 			Message: fmt.Sprintf("func '%s' should be passed context as first parameter",
 				decl.Name.Name),
 		})
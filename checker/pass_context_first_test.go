@@ -26,7 +26,9 @@ func TestPassContextFirstChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "pass_context_first",
 						Pos:     23,
+						End:     61,
 						Message: "func 'Foo' should be passed context as first parameter",
 					},
 				},
@@ -55,7 +57,9 @@ func TestPassContextFirstChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "pass_context_first",
 						Pos:     47,
+						End:     94,
 						Message: "func 'Bar' should be passed context as first parameter",
 					},
 				},
@@ -77,7 +81,7 @@ func TestPassContextFirstChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewPassContextFirstChecker(nil))
+			checker.Register(mustNew(NewPassContextFirstChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -86,3 +90,59 @@ func TestPassContextFirstChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestPassContextFirstCheckerTyped(t *testing.T) {
+	type test struct {
+		description string
+		input       string
+		expected    Report
+	}
+
+	tests := []test{
+		{
+			description: "aliased context is not first parameter",
+			input: `
+				package foo
+
+				import ctxpkg "context"
+
+				func Foo(one int, ctx ctxpkg.Context) {}
+			`,
+			expected: Report{
+				Errors: []Error{
+					{
+						Slug:    "pass_context_first",
+						Pos:     52,
+						End:     89,
+						Message: "func 'Foo' should be passed context as first parameter",
+					},
+				},
+			},
+		},
+		{
+			description: "non-context package named context",
+			input: `
+				package foo
+
+				import context "strings"
+
+				func Foo(one int, b context.Builder) {}
+			`,
+			expected: Report{
+				Errors: nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			checker := NewFileChecker()
+			checker.Register(mustNew(NewPassContextFirstChecker(nil)))
+
+			file, info := ParseTypedFileContent(test.input)
+			var report Report
+			checker.WithTypes(info).Check(file, "", &report)
+			assert.Equal(t, test.expected, report)
+		})
+	}
+}
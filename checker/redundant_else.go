@@ -3,19 +3,31 @@ package checker
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
+	"go/types"
 )
 
 func init() {
-	must(Register("redundant_else", NewRedundantElseChecker))
+	must(Register(redundantElseSlug, NewRedundantElseChecker))
 }
 
+const redundantElseSlug = "redundant_else"
+
 // RedundantElseChecker checks that if the body of an 'if' statement ends with a
 // terminating statement, there is no 'else' statement.
-type RedundantElseChecker struct{}
+type RedundantElseChecker struct {
+	elseIfs map[*ast.IfStmt]struct{}
+}
 
 // NewRedundantElseChecker constructs a RedundantElseChecker.
-func NewRedundantElseChecker(configData interface{}) NodeChecker {
-	return &RedundantElseChecker{}
+func NewRedundantElseChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(redundantElseSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &RedundantElseChecker{
+		elseIfs: map[*ast.IfStmt]struct{}{},
+	}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -61,8 +73,28 @@ func (c *RedundantElseChecker) Check(
 	content string,
 	report *Report) {
 
-	stmt := node.(*ast.IfStmt)
-	if stmt.Else == nil {
+	c.check(node.(*ast.IfStmt), nil, report)
+}
+
+// CheckTyped implements the TypedNodeChecker interface.
+func (c *RedundantElseChecker) CheckTyped(
+	node ast.Node,
+	info *TypeInfo,
+	report *Report) {
+
+	c.check(node.(*ast.IfStmt), info, report)
+}
+
+func (c *RedundantElseChecker) check(
+	stmt *ast.IfStmt,
+	info *TypeInfo,
+	report *Report) {
+
+	if elseIf, ok := stmt.Else.(*ast.IfStmt); ok {
+		c.elseIfs[elseIf] = struct{}{}
+	}
+
+	if stmt.Else == nil || len(stmt.Body.List) == 0 {
 		return
 	}
 
@@ -79,41 +111,99 @@ func (c *RedundantElseChecker) Check(
 			break
 		}
 
-		if c.isPanic(expr) {
+		if c.isPanic(expr, info) {
 			termStmt = "panic()"
-		} else if c.isExit(expr) {
+		} else if isQualifiedIdent(expr.Fun, "os.Exit", info) {
 			termStmt = "os.Exit()"
 		}
 	}
 
 	if termStmt != "" {
 		report.Errors = append(report.Errors, Error{
+			Slug:    redundantElseSlug,
 			Pos:     stmt.Else.Pos(),
+			End:     stmt.Else.End(),
 			Message: fmt.Sprintf("unexpected else after %s statement", termStmt),
+			Edits:   c.unwrapElse(stmt),
 		})
 	}
 }
 
-func (c *RedundantElseChecker) isPanic(expr *ast.CallExpr) bool {
-	ident, ok := expr.Fun.(*ast.Ident)
-	if !ok {
-		return false
+// unwrapElse returns the edits which move the else branch of `stmt` after
+// it. No edits are returned if that would change the meaning of the code.
+func (c *RedundantElseChecker) unwrapElse(stmt *ast.IfStmt) []Edit {
+	// The else branch may use variables declared by the if statement.
+	if stmt.Init != nil {
+		return nil
+	}
+
+	// An else if statement can be followed only by an else branch.
+	if _, ok := c.elseIfs[stmt]; ok {
+		return nil
+	}
+
+	switch elseStmt := stmt.Else.(type) {
+	case *ast.IfStmt:
+		return []Edit{
+			{
+				Pos:     stmt.Body.End(),
+				End:     elseStmt.Pos(),
+				NewText: "\n",
+			},
+		}
+
+	case *ast.BlockStmt:
+		// Declarations in the else branch may clash with the enclosing block.
+		for _, elseStmt := range elseStmt.List {
+			if declaresIdents(elseStmt) {
+				return nil
+			}
+		}
+
+		// The closing brace is removed with the line break before it so
+		// that no blank line is left after the unwrapped statements.
+		end := elseStmt.Lbrace + 1
+		if len(elseStmt.List) > 0 {
+			end = elseStmt.List[len(elseStmt.List)-1].End()
+		}
+
+		return []Edit{
+			{
+				Pos: stmt.Body.End(),
+				End: elseStmt.Lbrace + 1,
+			},
+			{
+				Pos: end,
+				End: elseStmt.Rbrace + 1,
+			},
+		}
+
+	default:
+		return nil
 	}
-	return ident.Name == "panic"
 }
 
-func (c *RedundantElseChecker) isExit(expr *ast.CallExpr) bool {
-	sel, ok := expr.Fun.(*ast.SelectorExpr)
+func (c *RedundantElseChecker) isPanic(expr *ast.CallExpr, info *TypeInfo) bool {
+	ident, ok := expr.Fun.(*ast.Ident)
 	if !ok {
 		return false
 	}
 
-	ident, ok := sel.X.(*ast.Ident)
-	if !ok {
-		return false
+	if info == nil {
+		return ident.Name == "panic"
 	}
 
-	// TODO: Handle the case where the os package is aliased in
-	// the scope of the file.
-	return ident.Name == "os" && sel.Sel.Name == "Exit"
+	return info.Info.Uses[ident] == types.Universe.Lookup("panic")
+}
+
+// declaresIdents reports whether `stmt` declares identifiers in its block.
+func declaresIdents(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.DeclStmt:
+		return true
+	case *ast.AssignStmt:
+		return stmt.Tok == token.DEFINE
+	default:
+		return false
+	}
 }
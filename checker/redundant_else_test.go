@@ -62,8 +62,20 @@ func TestRedundantElseChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "redundant_else",
 						Pos:     82,
+						End:     105,
 						Message: "unexpected else after return statement",
+						Edits: []Edit{
+							{
+								Pos: 76,
+								End: 83,
+							},
+							{
+								Pos: 98,
+								End: 105,
+							},
+						},
 					},
 				},
 			},
@@ -87,8 +99,20 @@ func TestRedundantElseChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "redundant_else",
 						Pos:     93,
+						End:     118,
 						Message: "unexpected else after break statement",
+						Edits: []Edit{
+							{
+								Pos: 87,
+								End: 94,
+							},
+							{
+								Pos: 110,
+								End: 118,
+							},
+						},
 					},
 				},
 			},
@@ -112,8 +136,20 @@ func TestRedundantElseChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "redundant_else",
 						Pos:     96,
+						End:     121,
 						Message: "unexpected else after continue statement",
+						Edits: []Edit{
+							{
+								Pos: 90,
+								End: 97,
+							},
+							{
+								Pos: 113,
+								End: 121,
+							},
+						},
 					},
 				},
 			},
@@ -134,8 +170,20 @@ func TestRedundantElseChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "redundant_else",
 						Pos:     84,
+						End:     107,
 						Message: "unexpected else after os.Exit() statement",
+						Edits: []Edit{
+							{
+								Pos: 78,
+								End: 85,
+							},
+							{
+								Pos: 100,
+								End: 107,
+							},
+						},
 					},
 				},
 			},
@@ -156,18 +204,110 @@ func TestRedundantElseChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "redundant_else",
 						Pos:     87,
+						End:     110,
 						Message: "unexpected else after panic() statement",
+						Edits: []Edit{
+							{
+								Pos: 81,
+								End: 88,
+							},
+							{
+								Pos: 103,
+								End: 110,
+							},
+						},
 					},
 				},
 			},
 		},
+		{
+			description: "return statement before else in else branch",
+			input: `
+				package test
+
+				func do() int {
+					if true {
+						foo()
+					} else if false {
+						return 1
+					} else {
+						return 2
+					}
+				}
+			`,
+			expected: Report{
+				Errors: []Error{
+					{
+						Slug:    "redundant_else",
+						Pos:     117,
+						End:     140,
+						Message: "unexpected else after return statement",
+					},
+				},
+			},
+		},
+		{
+			description: "return statement before else in switch case",
+			input: `
+				package test
+
+				func do(n int) int {
+					switch n {
+					case 1:
+						if true {
+							return 1
+						} else {
+							return 2
+						}
+					}
+					return 0
+				}
+			`,
+			expected: Report{
+				Errors: []Error{
+					{
+						Slug:    "redundant_else",
+						Pos:     119,
+						End:     144,
+						Message: "unexpected else after return statement",
+						Edits: []Edit{
+							{
+								Pos: 113,
+								End: 120,
+							},
+							{
+								Pos: 136,
+								End: 144,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "empty if body",
+			input: `
+				package test
+
+				func do() {
+					if true {
+					} else {
+						foo()
+					}
+				}
+			`,
+			expected: Report{
+				Errors: nil,
+			},
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewRedundantElseChecker(nil))
+			checker.Register(mustNew(NewRedundantElseChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -176,3 +316,119 @@ func TestRedundantElseChecker(t *testing.T) {
 		})
 	}
 }
+
+func TestRedundantElseCheckerTyped(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		expected    Report
+	}{
+		{
+			description: "aliased os.Exit() statement before else",
+			input: `
+				package test
+
+				import system "os"
+
+				func do() int {
+					if true {
+						system.Exit(1)
+					} else {
+						return 2
+					}
+				}
+			`,
+			expected: Report{
+				Errors: []Error{
+					{
+						Slug:    "redundant_else",
+						Pos:     112,
+						End:     135,
+						Message: "unexpected else after os.Exit() statement",
+						Edits: []Edit{
+							{
+								Pos: 106,
+								End: 113,
+							},
+							{
+								Pos: 128,
+								End: 135,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "shadowed panic() statement before else",
+			input: `
+				package test
+
+				func panic(msg string) {}
+
+				func do() int {
+					if true {
+						panic("oops")
+					} else {
+						return 2
+					}
+				}
+			`,
+			expected: Report{
+				Errors: nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			checker := NewFileChecker()
+			checker.Register(mustNew(NewRedundantElseChecker(nil)))
+
+			file, info := ParseTypedFileContent(test.input)
+			var report Report
+			checker.WithTypes(info).Check(file, "", &report)
+			assert.Equal(t, test.expected, report)
+		})
+	}
+}
+
+func TestRedundantElseCheckerFiles(t *testing.T) {
+	checker := NewFileChecker()
+	checker.Register(mustNew(NewRedundantElseChecker(nil)))
+
+	// The if statement in `file` starts at the same position as the else if
+	// statement in `previous`.
+	previous := ParseFileContent(`package test
+
+func do(a, b bool) int {
+	if a {
+		return 1
+	} else if b {
+		return 2
+	}
+	return 3
+}
+`)
+	checker.Check(previous, "", &Report{})
+
+	file := ParseFileContent(`package test
+
+func do(a, b bool) int {
+	if a {
+		return 1
+	}
+	/**/if b {
+		return 2
+	} else {
+		foo()
+	}
+	return 3
+}
+`)
+	var report Report
+	checker.Check(file, "", &report)
+	if assert.Len(t, report.Errors, 1) {
+		assert.NotEmpty(t, report.Errors[0].Edits)
+	}
+}
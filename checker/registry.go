@@ -1,14 +1,18 @@
 package checker
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
 
 // NodeCheckerConstructor constructs NodeChecker instances.
-type NodeCheckerConstructor func(configData interface{}) NodeChecker
+type NodeCheckerConstructor func(configData interface{}) (NodeChecker, error)
 
 // Register adds `checker` to the registry.
 func Register(slug string, constructor NodeCheckerConstructor) error {
 	if _, ok := registry[slug]; ok {
-		return fmt.Errorf("checker already registered: " + slug)
+		return fmt.Errorf("checker already registered: %s", slug)
 	}
 
 	registry[slug] = constructor
@@ -16,18 +20,50 @@ func Register(slug string, constructor NodeCheckerConstructor) error {
 	return nil
 }
 
-// Get returns the NodeChecker referenced by a `slug`.
-func Get(slug string, config interface{}) NodeChecker {
+// RegisterConfig adds the configuration struct `config` of the checker
+// referenced by `slug` to the registry. Its fields describe the options of
+// the checker.
+func RegisterConfig(slug string, config interface{}) error {
+	if _, ok := configs[slug]; ok {
+		return fmt.Errorf("checker config already registered: %s", slug)
+	}
+
+	configType := reflect.TypeOf(config)
+	if configType == nil || configType.Kind() != reflect.Struct {
+		return fmt.Errorf("checker config is not a struct: %s", slug)
+	}
+
+	configs[slug] = configType
+
+	return nil
+}
+
+// Get returns the NodeChecker referenced by a `slug`. An error is returned
+// if the checker is unknown or `config` is not valid for it.
+func Get(slug string, config interface{}) (NodeChecker, error) {
 	constructor, ok := registry[slug]
 	if !ok {
-		return nil
+		return nil, fmt.Errorf("unknown checker: %s", slug)
 	}
 
 	return constructor(config)
 }
 
+// Slugs returns the slugs of all registered checkers in sorted order.
+func Slugs() []string {
+	var slugs []string
+	for slug := range registry {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	return slugs
+}
+
 var registry = map[string]NodeCheckerConstructor{}
 
+var configs = map[string]reflect.Type{}
+
 func must(err error) {
 	if err != nil {
 		panic(err.Error())
@@ -3,6 +3,7 @@ package checker_test
 import (
 	"fmt"
 	"go/ast"
+	"sort"
 	"testing"
 
 	. "github.com/s2gatev/lingo/checker"
@@ -11,24 +12,26 @@ import (
 
 func TestRegistryRegister(t *testing.T) {
 	checker := &dummyChecker{}
-	err := Register("dummy", func(configData interface{}) NodeChecker {
-		return checker
+	err := Register("dummy", func(configData interface{}) (NodeChecker, error) {
+		return checker, nil
 	})
 	assert.Nil(t, err)
-	assert.Equal(t, checker, Get("dummy", nil))
+	assert.Equal(t, checker, mustNew(Get("dummy", nil)))
 }
 
 func TestRegistryRegisterAlreadyPresent(t *testing.T) {
 	checker := &dummyChecker{}
-	err := Register("dummy", func(configData interface{}) NodeChecker {
-		return checker
+	err := Register("dummy", func(configData interface{}) (NodeChecker, error) {
+		return checker, nil
 	})
 	assert.Equal(t, fmt.Errorf("checker already registered: dummy"), err)
-	assert.Equal(t, checker, Get("dummy", nil))
+	assert.Equal(t, checker, mustNew(Get("dummy", nil)))
 }
 
 func TestRegistryGetNotPresent(t *testing.T) {
-	assert.Nil(t, Get("unknown", nil))
+	c, err := Get("unknown", nil)
+	assert.Nil(t, c)
+	assert.Equal(t, fmt.Errorf("unknown checker: unknown"), err)
 }
 
 type dummyChecker struct{}
@@ -48,3 +51,23 @@ func (c *dummyChecker) Examples() []Example {
 func (c *dummyChecker) Register(fc *FileChecker) {}
 
 func (c *dummyChecker) Check(node ast.Node, content string, report *Report) {}
+
+func TestRegistrySlugs(t *testing.T) {
+	slugs := Slugs()
+	assert.Contains(t, slugs, "line_length")
+	assert.True(t, sort.StringsAreSorted(slugs))
+}
+
+func TestRegistryRegisterConfig(t *testing.T) {
+	type dummyConfig struct {
+		Max int `mapdecode:"max"`
+	}
+
+	assert.Nil(t, RegisterConfig("dummy_config", dummyConfig{}))
+	assert.Equal(t,
+		fmt.Errorf("checker config already registered: dummy_config"),
+		RegisterConfig("dummy_config", dummyConfig{}))
+	assert.Equal(t,
+		fmt.Errorf("checker config is not a struct: dummy_pointer"),
+		RegisterConfig("dummy_pointer", &dummyConfig{}))
+}
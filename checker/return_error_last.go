@@ -6,16 +6,22 @@ import (
 )
 
 func init() {
-	must(Register("return_error_last", NewReturnErrorLastChecker))
+	must(Register(returnErrorLastSlug, NewReturnErrorLastChecker))
 }
 
+const returnErrorLastSlug = "return_error_last"
+
 // ReturnErrorLastChecker checks that error is the last value returned
 // by a func.
 type ReturnErrorLastChecker struct{}
 
 // NewReturnErrorLastChecker constructs a ReturnErrorLastChecker.
-func NewReturnErrorLastChecker(configData interface{}) NodeChecker {
-	return &ReturnErrorLastChecker{}
+func NewReturnErrorLastChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(returnErrorLastSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &ReturnErrorLastChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -70,7 +76,9 @@ func (c *ReturnErrorLastChecker) Check(
 
 	if errorNotLast {
 		report.Errors = append(report.Errors, Error{
-			Pos: node.Pos(),
+			Slug: returnErrorLastSlug,
+			Pos:  node.Pos(),
+			End:  decl.Type.End(),
 			Message: fmt.Sprintf("func '%s' should return error as the last value",
 				decl.Name.Name),
 		})
@@ -26,7 +26,9 @@ func TestReturnErrorLastChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "return_error_last",
 						Pos:     23,
+						End:     52,
 						Message: "func 'Foo' should return error as the last value",
 					},
 				},
@@ -70,7 +72,7 @@ func TestReturnErrorLastChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewReturnErrorLastChecker(nil))
+			checker.Register(mustNew(NewReturnErrorLastChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -0,0 +1,168 @@
+package checker
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+const suppressionSlug = "suppression"
+
+const (
+	// ignoreDirective suppresses violations on the line of the comment or,
+	// if the comment is on a line of its own, on the line following it.
+	ignoreDirective = "//lingo:ignore"
+
+	// fileIgnoreDirective suppresses violations in the whole file.
+	fileIgnoreDirective = "//lingo:file-ignore"
+)
+
+// suppressions holds the violations suppressed by lingo comments in a file.
+type suppressions struct {
+	lines  *lineIndex
+	file   map[string]struct{}
+	byLine map[int]map[string]struct{}
+
+	// errors contains the violations of malformed suppression comments.
+	errors []Error
+}
+
+// newSuppressions collects the suppression comments in `file`. If
+// `requireReason` is set comments which do not state a reason are
+// reported as violations instead.
+func newSuppressions(
+	file *ast.File,
+	lines *lineIndex,
+	requireReason bool) *suppressions {
+
+	s := &suppressions{
+		lines:  lines,
+		file:   map[string]struct{}{},
+		byLine: map[int]map[string]struct{}{},
+	}
+
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			s.parse(comment, requireReason)
+		}
+	}
+
+	return s
+}
+
+func (s *suppressions) parse(comment *ast.Comment, requireReason bool) {
+	var directive string
+	switch {
+	case strings.HasPrefix(comment.Text, fileIgnoreDirective+" "):
+		directive = fileIgnoreDirective
+	case strings.HasPrefix(comment.Text, ignoreDirective+" "):
+		directive = ignoreDirective
+	default:
+		return
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(comment.Text, directive))
+	if len(fields) == 0 {
+		return
+	}
+
+	if requireReason && len(fields) < 2 {
+		s.errors = append(s.errors, Error{
+			Slug:    suppressionSlug,
+			Pos:     comment.Pos(),
+			End:     comment.End(),
+			Message: fmt.Sprintf("%s must state a reason", directive),
+		})
+		return
+	}
+
+	slugs := map[string]struct{}{}
+	for _, slug := range strings.Split(fields[0], ",") {
+		slugs[slug] = struct{}{}
+	}
+
+	if directive == fileIgnoreDirective {
+		for slug := range slugs {
+			s.file[slug] = struct{}{}
+		}
+		return
+	}
+
+	commentLine := s.lines.line(comment.Pos())
+	if commentLine == 0 {
+		return
+	}
+
+	// A comment on a line of its own applies to the following line.
+	line := commentLine
+	if s.lines.startsLine(comment.Pos()) {
+		line++
+	}
+
+	if s.byLine[line] == nil {
+		s.byLine[line] = map[string]struct{}{}
+	}
+	for slug := range slugs {
+		s.byLine[line][slug] = struct{}{}
+	}
+}
+
+// suppresses reports whether `err` is suppressed.
+func (s *suppressions) suppresses(err Error) bool {
+	if _, ok := s.file[err.Slug]; ok {
+		return true
+	}
+
+	_, ok := s.byLine[s.lines.line(err.Pos)][err.Slug]
+	return ok
+}
+
+// lineIndex maps positions in the content of a file to lines.
+type lineIndex struct {
+	content string
+	base    int
+	starts  []token.Pos
+}
+
+// newLineIndex indexes the lines of `content` which starts at position
+// `base`.
+func newLineIndex(content string, base int) *lineIndex {
+	index := &lineIndex{
+		content: content,
+		base:    base,
+	}
+
+	if len(content) == 0 {
+		return index
+	}
+
+	index.starts = append(index.starts, token.Pos(base))
+	for offset, char := range content {
+		if char == '\n' {
+			index.starts = append(index.starts, token.Pos(base+offset+1))
+		}
+	}
+
+	return index
+}
+
+// line returns the line number of `pos` starting from 1 or 0 if the line
+// cannot be determined.
+func (l *lineIndex) line(pos token.Pos) int {
+	return sort.Search(len(l.starts), func(i int) bool {
+		return l.starts[i] > pos
+	})
+}
+
+// startsLine reports whether only whitespace precedes `pos` on its line.
+func (l *lineIndex) startsLine(pos token.Pos) bool {
+	line := l.line(pos)
+	if line == 0 {
+		return false
+	}
+
+	start := int(l.starts[line-1]) - l.base
+	return strings.TrimSpace(l.content[start:int(pos)-l.base]) == ""
+}
@@ -0,0 +1,152 @@
+package checker_test
+
+import (
+	"testing"
+
+	. "github.com/s2gatev/lingo/checker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppression(t *testing.T) {
+	type test struct {
+		description   string
+		input         string
+		requireReason bool
+		expected      []string
+	}
+
+	tests := []test{
+		{
+			description: "no suppression",
+			input: `package test
+
+var foo_bar1 int
+`,
+			expected: []string{"name 'foo_bar1' is not valid"},
+		},
+		{
+			description: "suppression on the same line",
+			input: `package test
+
+var foo_bar1 int //lingo:ignore multi_word_ident_name generated
+var foo_bar2 int
+`,
+			expected: []string{"name 'foo_bar2' is not valid"},
+		},
+		{
+			description: "suppression above the line",
+			input: `package test
+
+//lingo:ignore multi_word_ident_name generated
+var foo_bar1 int
+var foo_bar2 int
+`,
+			expected: []string{"name 'foo_bar2' is not valid"},
+		},
+		{
+			description: "suppression of multiple checkers",
+			input: `package test
+
+//lingo:ignore line_length,multi_word_ident_name generated
+var foo_bar1 int
+`,
+			expected: nil,
+		},
+		{
+			description: "suppression of another checker",
+			input: `package test
+
+//lingo:ignore line_length generated
+var foo_bar1 int
+`,
+			expected: []string{"name 'foo_bar1' is not valid"},
+		},
+		{
+			description: "file suppression",
+			input: `//lingo:file-ignore multi_word_ident_name generated
+
+package test
+
+var foo_bar1 int
+
+var foo_bar2 int
+`,
+			expected: nil,
+		},
+		{
+			description: "suppression without reason",
+			input: `package test
+
+//lingo:ignore multi_word_ident_name
+var foo_bar1 int
+`,
+			expected: nil,
+		},
+		{
+			description:   "suppression without required reason",
+			requireReason: true,
+			input: `package test
+
+//lingo:ignore multi_word_ident_name
+var foo_bar1 int
+`,
+			expected: []string{
+				"name 'foo_bar1' is not valid",
+				"//lingo:ignore must state a reason",
+			},
+		},
+		{
+			description:   "suppression with required reason",
+			requireReason: true,
+			input: `package test
+
+//lingo:ignore multi_word_ident_name generated
+var foo_bar1 int
+`,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			checker := NewFileChecker()
+			checker.Register(mustNew(NewMultiWordIdentNameChecker(nil)))
+			if test.requireReason {
+				checker.RequireSuppressionReason()
+			}
+
+			file := ParseFileContent(test.input)
+			var report Report
+			checker.Check(file, test.input, &report)
+
+			var messages []string
+			for _, err := range report.Errors {
+				messages = append(messages, err.Message)
+			}
+			assert.Equal(t, test.expected, messages)
+		})
+	}
+}
+
+func TestSuppressionReportsSuppressed(t *testing.T) {
+	input := `package test
+
+//lingo:ignore multi_word_ident_name generated
+var foo_bar1 int
+var foo_bar2 int
+`
+
+	checker := NewFileChecker()
+	checker.Register(mustNew(NewMultiWordIdentNameChecker(nil)))
+
+	file := ParseFileContent(input)
+	var report Report
+	checker.Check(file, input, &report)
+
+	var messages []string
+	for _, err := range report.Suppressed {
+		messages = append(messages, err.Message)
+	}
+	assert.Equal(t, []string{"name 'foo_bar1' is not valid"}, messages)
+}
@@ -7,16 +7,22 @@ import (
 )
 
 func init() {
-	must(Register("test_package", NewTestPackageChecker))
+	must(Register(testPackageSlug, NewTestPackageChecker))
 }
 
+const testPackageSlug = "test_package"
+
 // TestPackageChecker checks that tests are placed in "*_test" packages
 // only.
 type TestPackageChecker struct{}
 
 // NewTestPackageChecker constructs a TestPackageChecker.
-func NewTestPackageChecker(configData interface{}) NodeChecker {
-	return &TestPackageChecker{}
+func NewTestPackageChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(testPackageSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
+	return &TestPackageChecker{}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -78,7 +84,9 @@ func (c *TestPackageChecker) Check(
 	}
 
 	report.Errors = append(report.Errors, Error{
-		Pos: node.Pos(),
+		Slug: testPackageSlug,
+		Pos:  node.Pos(),
+		End:  file.Name.End(),
 		Message: fmt.Sprintf("package '%s' should be named '%s_test'",
 			packageName, packageName),
 	})
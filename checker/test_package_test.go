@@ -28,7 +28,9 @@ func TestTestPackageChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "test_package",
 						Pos:     6,
+						End:     17,
 						Message: "package 'foo' should be named 'foo_test'",
 					},
 				},
@@ -63,7 +65,7 @@ func TestTestPackageChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewTestPackageChecker(nil))
+			checker.Register(mustNew(NewTestPackageChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
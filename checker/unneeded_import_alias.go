@@ -8,9 +8,11 @@ import (
 )
 
 func init() {
-	must(Register("unneeded_import_alias", NewUnneededImportAliasChecker))
+	must(Register(unneededImportAliasSlug, NewUnneededImportAliasChecker))
 }
 
+const unneededImportAliasSlug = "unneeded_import_alias"
+
 // UnneededImportAliasChecker checks that import aliases are used only when
 // necessary.
 type UnneededImportAliasChecker struct {
@@ -18,10 +20,14 @@ type UnneededImportAliasChecker struct {
 }
 
 // NewUnneededImportAliasChecker constructs a UnneededImportAliasChecker.
-func NewUnneededImportAliasChecker(configData interface{}) NodeChecker {
+func NewUnneededImportAliasChecker(configData interface{}) (NodeChecker, error) {
+	if err := decodeConfig(unneededImportAliasSlug, configData, nil); err != nil {
+		return nil, err
+	}
+
 	return &UnneededImportAliasChecker{
 		packageNames: map[string]string{},
-	}
+	}, nil
 }
 
 // Title implements the NodeChecker interface.
@@ -101,8 +107,11 @@ func (c *UnneededImportAliasChecker) Check(
 		}
 
 		report.Errors = append(report.Errors, Error{
+			Slug:    unneededImportAliasSlug,
 			Pos:     importSpec.Pos(),
+			End:     importSpec.Name.End(),
 			Message: fmt.Sprintf("unneeded package alias: %s", aliasName),
+			Edits:   c.removeAlias(file, importSpec),
 		})
 	}
 }
@@ -125,6 +134,64 @@ func (c *UnneededImportAliasChecker) extractPackageName(
 	return packageName, nil
 }
 
+// removeAlias returns the edits which remove the alias of `importSpec` and
+// rename its uses in `file` to the name of the package. No edits are returned
+// if the name of the package is already used in the file.
+func (c *UnneededImportAliasChecker) removeAlias(
+	file *ast.File,
+	importSpec *ast.ImportSpec) []Edit {
+
+	packageName, err := c.extractPackageName(importSpec)
+	if err != nil {
+		return nil
+	}
+
+	edits := []Edit{
+		{
+			Pos: importSpec.Name.Pos(),
+			End: importSpec.Path.Pos(),
+		},
+	}
+
+	// The alias itself and the selectors which refer to the import are
+	// renamed, so they do not conflict with the name of the package.
+	renamed := map[*ast.Ident]bool{
+		importSpec.Name: true,
+	}
+
+	conflict := false
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.Ident:
+			if node.Name == packageName && !renamed[node] {
+				conflict = true
+			}
+
+		case *ast.SelectorExpr:
+			// Unresolved identifiers in selectors refer to imported packages.
+			ident, ok := node.X.(*ast.Ident)
+			if ok && ident.Name == importSpec.Name.Name && ident.Obj == nil {
+				renamed[ident] = true
+				if ident.Name != packageName {
+					edits = append(edits, Edit{
+						Pos:     ident.Pos(),
+						End:     ident.End(),
+						NewText: packageName,
+					})
+				}
+			}
+		}
+
+		return !conflict
+	})
+
+	if conflict {
+		return nil
+	}
+
+	return edits
+}
+
 func hasAlias(importSpec *ast.ImportSpec) bool {
 	return importSpec.Name != nil
 }
@@ -51,8 +51,16 @@ func TestUnneededImportAliasChecker(t *testing.T) {
 			expected: Report{
 				Errors: []Error{
 					{
+						Slug:    "unneeded_import_alias",
 						Pos:     31,
+						End:     40,
 						Message: "unneeded package alias: something",
+						Edits: []Edit{
+							{
+								Pos: 31,
+								End: 41,
+							},
+						},
 					},
 				},
 			},
@@ -98,7 +106,7 @@ func TestUnneededImportAliasChecker(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.description, func(t *testing.T) {
 			checker := NewFileChecker()
-			checker.Register(NewUnneededImportAliasChecker(nil))
+			checker.Register(mustNew(NewUnneededImportAliasChecker(nil)))
 
 			file := ParseFileContent(test.input)
 			var report Report
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"runtime"
+
+	"github.com/s2gatev/lingo/baseline"
+	"github.com/s2gatev/lingo/cli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	Baseline.PersistentFlags().StringVar(
+		&configFile, "config", defaultConfigFilename, "config file")
+	Baseline.PersistentFlags().IntVar(
+		&jobs, "jobs", runtime.NumCPU(), "number of files checked in parallel")
+	Baseline.PersistentFlags().StringVar(
+		&baselineFile, "baseline", defaultBaselineFilename, "baseline file")
+	Baseline.PersistentFlags().BoolVar(
+		&baselinePrune, "prune", false,
+		"only remove the violations which no longer occur from the baseline")
+
+	Root.AddCommand(Baseline)
+}
+
+// Baseline is a command handler that records the lingo violations in a
+// directory in a baseline file. Violations in the baseline are not reported
+// by lingo check --baseline.
+var Baseline = &cobra.Command{
+	Use:   "baseline <path>",
+	Short: "Record the lingo violations of all files in a directory",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		resolveConfigFile(cmd, args[0])
+
+		config, err := loadConfig(configFile)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		// Pruning keeps only the recorded violations which still occur
+		// and does not record new ones.
+		var previous *baseline.Baseline
+		if baselinePrune {
+			previous, err = baseline.Load(baselineFile)
+			if err != nil {
+				cli.ExitError("%s", err)
+			}
+		}
+
+		run := newCheckRun()
+		if !cmd.Flags().Changed("config") {
+			run.discoverConfigs()
+		}
+		reports, err := run.checkDir(config, args[0])
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		b := baseline.New(baselineFile)
+		for _, path := range reportPaths(reports) {
+			for _, err := range reports[path].Errors {
+				violation := run.baselineViolation(path, err)
+				if previous == nil || previous.Match(violation) {
+					b.Add(violation)
+				}
+			}
+		}
+
+		if err := b.Save(); err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		if previous != nil {
+			cli.ExitOK("%d violations pruned, %d violations recorded in %s",
+				previous.Len()-b.Len(), b.Len(), baselineFile)
+		}
+
+		cli.ExitOK("%d violations recorded in %s", b.Len(), baselineFile)
+	},
+}
+
+const defaultBaselineFilename = "lingo-baseline.json"
+
+var baselineFile string
+
+var baselinePrune bool
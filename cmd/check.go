@@ -1,21 +1,42 @@
 package cmd
 
 import (
+	"bytes"
 	"fmt"
-	"go/parser"
-	"go/token"
-	"io/ioutil"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
 
+	"github.com/s2gatev/lingo/baseline"
 	"github.com/s2gatev/lingo/checker"
 	"github.com/s2gatev/lingo/cli"
-	"github.com/s2gatev/lingo/file"
+	"github.com/s2gatev/lingo/diff"
+	"github.com/s2gatev/lingo/reporter"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v2"
 )
 
 func init() {
 	Check.PersistentFlags().StringVar(
 		&configFile, "config", defaultConfigFilename, "config file")
+	Check.PersistentFlags().IntVar(
+		&jobs, "jobs", runtime.NumCPU(), "number of files checked in parallel")
+	Check.PersistentFlags().BoolVar(
+		&checkFix, "fix", false, "fix the violations which have a fix")
+	Check.PersistentFlags().StringVar(
+		&checkBaseline, "baseline", "", "baseline file of violations to ignore")
+	Check.PersistentFlags().StringVar(
+		&checkDiff, "diff", "",
+		"report only violations on lines changed by a unified diff file or - for stdin")
+	Check.PersistentFlags().StringVar(
+		&checkNewFromRev, "new-from-rev", "",
+		"report only violations on lines changed since a git revision")
+	Check.PersistentFlags().StringVar(
+		&checkFailOn, "fail-on", defaultFailOn,
+		"lowest severity of violations which fail the check: error, warning, info")
+	Check.PersistentFlags().StringVar(
+		&checkFormat, "format", defaultFormat,
+		"output format: "+strings.Join(reporter.Slugs(), ", "))
 
 	Root.AddCommand(Check)
 }
@@ -23,81 +44,109 @@ func init() {
 // Check is a command handler that checks the lingo in a directory
 // for violations.
 var Check = &cobra.Command{
-	Use:   "check",
+	Use:   "check <path>",
 	Short: "Check the lingo of all files in a directory",
+	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		configData, err := ioutil.ReadFile(configFile)
-		if err != nil {
-			cli.ExitError("failed to read config file: %s", configFile)
-		}
+		resolveConfigFile(cmd, args[0])
 
-		var config Config
-		if err := yaml.Unmarshal(configData, &config); err != nil {
-			cli.ExitError("failed to parse config file: %s", configFile)
+		config, err := loadConfig(configFile)
+		if err != nil {
+			cli.ExitError("%s", err)
 		}
 
-		var matchers []file.Matcher
-		for _, matcher := range config.Matchers {
-			matchers = append(matchers, file.Get(matcher.Type, matcher.Config))
+		rep := reporter.Get(checkFormat)
+		if rep == nil {
+			cli.ExitError("unknown format: %s", checkFormat)
 		}
-		feeder := file.NewFeeder(matchers...)
-
-		fc := checker.NewFileChecker()
-		for slug, config := range config.Checkers {
-			c := checker.Get(slug, config)
-			if c == nil {
-				cli.ExitError("unknown checker: %s", slug)
-			}
 
-			fc.Register(c)
+		failOn, err := checker.ParseSeverity(checkFailOn)
+		if err != nil {
+			cli.ExitError("%s", err)
 		}
 
-		files, err := feeder.Feed(args[0])
+		d, err := loadDiff()
 		if err != nil {
-			cli.ExitError("failed to process files: %s", args[0])
+			cli.ExitError("%s", err)
 		}
 
-		reports := map[string]*checker.Report{}
-		fileSets := map[string]*token.FileSet{}
+		var b *baseline.Baseline
+		if checkBaseline != "" {
+			b, err = baseline.Load(checkBaseline)
+			if err != nil {
+				cli.ExitError("%s", err)
+			}
+		}
 
-		for path := range files {
-			reports[path] = &checker.Report{}
-			fileSets[path] = token.NewFileSet()
+		check := func() (*checkRun, map[string]*checker.Report) {
+			run := newCheckRun()
+			if !cmd.Flags().Changed("config") {
+				run.discoverConfigs()
+			}
+			if d != nil {
+				run.restrict(d)
+			}
 
-			content, err := ioutil.ReadFile(path)
+			reports, err := run.checkDir(config, args[0])
 			if err != nil {
-				panic(err)
+				cli.ExitError("%s", err)
 			}
 
-			file, err := parser.ParseFile(
-				fileSets[path],
-				path,
-				nil,
-				parser.ParseComments)
-			if err != nil {
-				cli.ExitError("failed to parse file: %s", path)
+			if d != nil {
+				run.applyDiff(reports, d)
+			}
+			if b != nil {
+				run.applyBaseline(reports, b)
 			}
 
-			fc.Check(file, string(content), reports[path])
+			return run, reports
 		}
 
-		totalErrors := 0
-		for path, report := range reports {
-			if len(report.Errors) == 0 {
-				continue
+		run, reports := check()
+		if checkFix {
+			fixedErrors, err := run.fix(reports, true)
+			if err != nil {
+				cli.ExitError("%s", err)
 			}
 
-			fmt.Println(path)
-			for _, err := range report.Errors {
-				position := fileSets[path].Position(err.Pos)
-				fmt.Printf("\t- line %d: %s\n", position.Line, err.Message)
+			// The fixes move the code after them, so the remaining violations
+			// are found again in the fixed files.
+			if fixedErrors > 0 {
+				run, reports = check()
 			}
-			fmt.Println()
+		}
+
+		checkers, err := run.configs.newCheckers()
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		result, err := run.result(reports, checkers)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		if err := rep.Report(os.Stdout, result); err != nil {
+			cli.ExitError("failed to write report: %s", err)
+		}
+
+		totalErrors := result.ViolationCount()
+		failingErrors := result.ViolationCountAtLeast(failOn)
 
-			totalErrors += len(report.Errors)
+		// Only the text format is followed by a summary so that the output
+		// of the other formats can be parsed.
+		if checkFormat != defaultFormat {
+			if failingErrors > 0 {
+				os.Exit(1)
+			}
+			return
 		}
 
 		if totalErrors > 0 {
+			fmt.Println()
+		}
+
+		if failingErrors > 0 {
 			cli.ExitError("%d violations found in %d files",
 				totalErrors, len(reports))
 		} else {
@@ -106,3 +155,62 @@ var Check = &cobra.Command{
 		}
 	},
 }
+
+// loadDiff reads the unified diff selected by the --diff or --new-from-rev
+// flag. Paths in the diff are relative to the working directory. If neither
+// flag is set no diff is returned.
+func loadDiff() (*diff.Diff, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case checkDiff != "" && checkNewFromRev != "":
+		return nil, fmt.Errorf("--diff and --new-from-rev cannot be used together")
+
+	case checkDiff == "-":
+		return diff.Parse(os.Stdin, wd)
+
+	case checkDiff != "":
+		diffFile, err := os.Open(checkDiff)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read diff file: %s", checkDiff)
+		}
+		defer diffFile.Close()
+
+		return diff.Parse(diffFile, wd)
+
+	case checkNewFromRev != "":
+		// Paths are made relative to the working directory to match the
+		// paths of diff files.
+		output, err := exec.Command("git", "diff", "--relative", "--no-color",
+			"--no-ext-diff", checkNewFromRev).Output()
+		if err != nil {
+			return nil, fmt.Errorf("failed to run git diff: %s", checkNewFromRev)
+		}
+
+		return diff.Parse(bytes.NewReader(output), wd)
+
+	default:
+		return nil, nil
+	}
+}
+
+var jobs int
+
+var checkFix bool
+
+var checkBaseline string
+
+var checkDiff string
+
+var checkNewFromRev string
+
+const defaultFormat = "text"
+
+const defaultFailOn = "error"
+
+var checkFailOn string
+
+var checkFormat string
@@ -0,0 +1,722 @@
+package cmd
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/s2gatev/lingo/baseline"
+	"github.com/s2gatev/lingo/checker"
+	"github.com/s2gatev/lingo/diff"
+	"github.com/s2gatev/lingo/file"
+	"github.com/s2gatev/lingo/reporter"
+	"golang.org/x/tools/go/loader"
+)
+
+// newCheckers creates new instances of all checkers in `config` mapped by
+// their slugs.
+func newCheckers(config *Config) (map[string]checker.NodeChecker, error) {
+	checkers := map[string]checker.NodeChecker{}
+	for slug := range config.Checkers {
+		c, err := config.newChecker(slug)
+		if err != nil {
+			return nil, err
+		}
+
+		checkers[slug] = c
+	}
+
+	return checkers, nil
+}
+
+// newFileChecker creates a FileChecker with new instances of all checkers
+// in `config`. Checkers with matchers check only the files they match and
+// the violations of checkers with a severity are reported with it.
+func newFileChecker(config *Config) (*checker.FileChecker, error) {
+	checkers, err := newCheckers(config)
+	if err != nil {
+		return nil, err
+	}
+
+	fc := checker.NewFileChecker()
+	for slug, c := range checkers {
+		matchers, err := config.newCheckerMatchers(slug)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matchers) > 0 {
+			fc.RegisterMatching(c, matchers...)
+		} else {
+			fc.Register(c)
+		}
+
+		if config.Checkers[slug].Severity == "" {
+			continue
+		}
+
+		severity, err := config.checkerSeverity(slug)
+		if err != nil {
+			return nil, err
+		}
+		fc.SetSeverity(slug, severity)
+	}
+
+	if config.Suppression.RequireReason {
+		fc.RequireSuppressionReason()
+	}
+
+	return fc, nil
+}
+
+// checkRun holds the state of a single run of lingo check. Files are parsed
+// and checked by `jobs` goroutines in parallel.
+type checkRun struct {
+	mutex     sync.Mutex
+	filter    file.Matcher
+	discover  bool
+	configs   *configTree
+	fileSet   *token.FileSet
+	files     map[string]*ast.File
+	contents  map[string]string
+	packages  map[packageKey][]*ast.File
+	typeInfos map[*ast.File]*checker.TypeInfo
+}
+
+// packageKey identifies a package by its directory and name.
+type packageKey struct {
+	dir  string
+	name string
+}
+
+// checkTask is a unit of work of a check run. It is either a single file
+// identified by `path` or all `files` of a package, checked with `config`.
+type checkTask struct {
+	path   string
+	files  []*ast.File
+	config *Config
+}
+
+// checkResult is the result of a checkTask.
+type checkResult struct {
+	task   checkTask
+	report *checker.Report
+}
+
+func newCheckRun() *checkRun {
+	return &checkRun{
+		fileSet:   token.NewFileSet(),
+		files:     map[string]*ast.File{},
+		contents:  map[string]string{},
+		packages:  map[packageKey][]*ast.File{},
+		typeInfos: map[*ast.File]*checker.TypeInfo{},
+	}
+}
+
+// discoverConfigs enables config files in the subdirectories of the working
+// directory. Every file is checked with the config file in its nearest
+// directory instead of the config passed to checkDir. Discovery is meant for
+// the config files found from the checked path, not for a config file set
+// with the --config flag.
+func (r *checkRun) discoverConfigs() {
+	r.discover = true
+}
+
+// checkDir checks all files rooted at `root` which match the matchers of
+// their config and returns a report for every file.
+func (r *checkRun) checkDir(
+	config *Config,
+	root string) (map[string]*checker.Report, error) {
+
+	configs, err := newConfigTree(config, r.discover)
+	if err != nil {
+		return nil, err
+	}
+	r.configs = configs
+
+	matchers := []file.Matcher{goFileMatcher{}, configs}
+	if r.filter != nil {
+		matchers = append(matchers, r.filter)
+	}
+	feeder := file.NewFeeder(matchers...)
+
+	if _, err := newFileChecker(config); err != nil {
+		return nil, err
+	}
+
+	if jobs < 1 {
+		return nil, fmt.Errorf("invalid number of jobs: %d", jobs)
+	}
+
+	paths, err := feeder.Feed(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to process files: %s", root)
+	}
+
+	if err := r.parse(paths); err != nil {
+		return nil, err
+	}
+	if configs.err != nil {
+		return nil, configs.err
+	}
+
+	fileCheckers, err := r.newFileCheckers()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fc := range fileCheckers {
+		if fc.HasTypedCheckers() {
+			if err := r.loadTypes(); err != nil {
+				return nil, fmt.Errorf("failed to load packages: %s", err)
+			}
+			break
+		}
+	}
+
+	return r.check()
+}
+
+// newFileCheckers creates a FileChecker for every config of the run.
+func (r *checkRun) newFileCheckers() (map[*Config]*checker.FileChecker, error) {
+	fileCheckers := map[*Config]*checker.FileChecker{}
+	for _, config := range r.configs.all() {
+		fc, err := newFileChecker(config)
+		if err != nil {
+			return nil, err
+		}
+
+		fc.SetFileSet(r.fileSet)
+		fileCheckers[config] = fc
+	}
+
+	return fileCheckers, nil
+}
+
+// parse parses all files fed by `paths` and groups them by package.
+func (r *checkRun) parse(paths <-chan string) error {
+	errs := make(chan error, jobs)
+
+	var wg sync.WaitGroup
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			// Keep draining `paths` after an error so that the feeder
+			// is not blocked.
+			var err error
+			for path := range paths {
+				if err == nil {
+					err = r.parseFile(path)
+				}
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	// Files are grouped in a fixed order so that package checkers report
+	// the same violations on every run.
+	var sortedPaths []string
+	for path := range r.files {
+		sortedPaths = append(sortedPaths, path)
+	}
+	sort.Strings(sortedPaths)
+
+	for _, path := range sortedPaths {
+		file := r.files[path]
+		key := packageKey{
+			dir:  filepath.Dir(path),
+			name: file.Name.Name,
+		}
+		r.packages[key] = append(r.packages[key], file)
+	}
+
+	return nil
+}
+
+func (r *checkRun) parseFile(path string) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %s", path)
+	}
+
+	file, err := parser.ParseFile(r.fileSet, path, content, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("failed to parse file: %s", path)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.files[path] = file
+	r.contents[path] = string(content)
+
+	return nil
+}
+
+// loadTypes type-checks all packages of the run. Type errors are ignored
+// so that files which depend on packages that cannot be loaded are still
+// checked.
+func (r *checkRun) loadTypes() error {
+	config := &loader.Config{
+		Fset:        r.fileSet,
+		ParserMode:  parser.ParseComments,
+		AllowErrors: true,
+		TypeChecker: types.Config{
+			Error: func(err error) {},
+		},
+	}
+	for key, files := range r.packages {
+		config.CreateFromFiles(key.dir, files...)
+	}
+
+	program, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range program.Created {
+		info := &checker.TypeInfo{
+			Package: pkg.Pkg,
+			Info:    &pkg.Info,
+		}
+
+		for _, file := range pkg.Files {
+			r.typeInfos[file] = info
+		}
+	}
+
+	return nil
+}
+
+// check checks all files and packages of the run with the checkers of their
+// configs and returns a report for every file. Every goroutine uses its own
+// instances of the checkers as they are not safe for concurrent use.
+func (r *checkRun) check() (map[string]*checker.Report, error) {
+	var fileCheckers []map[*Config]*checker.FileChecker
+	for i := 0; i < jobs; i++ {
+		fcs, err := r.newFileCheckers()
+		if err != nil {
+			return nil, err
+		}
+
+		fileCheckers = append(fileCheckers, fcs)
+	}
+
+	checkTasks, err := r.tasks()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make(chan checkTask)
+	results := make(chan checkResult)
+
+	var wg sync.WaitGroup
+	for _, fcs := range fileCheckers {
+		wg.Add(1)
+		go func(fcs map[*Config]*checker.FileChecker) {
+			defer wg.Done()
+
+			for task := range tasks {
+				results <- r.checkTask(fcs[task.config], task)
+			}
+		}(fcs)
+	}
+
+	go func() {
+		for _, task := range checkTasks {
+			tasks <- task
+		}
+		close(tasks)
+
+		wg.Wait()
+		close(results)
+	}()
+
+	fileErrors := map[string][]checker.Error{}
+	suppressedErrors := map[string][]checker.Error{}
+	packageErrors := map[string][]checker.Error{}
+	packageSuppressed := map[string][]checker.Error{}
+	for result := range results {
+		if result.task.files == nil {
+			fileErrors[result.task.path] = result.report.Errors
+			suppressedErrors[result.task.path] = result.report.Suppressed
+			continue
+		}
+
+		for _, err := range result.report.Errors {
+			path := r.fileSet.Position(err.Pos).Filename
+			packageErrors[path] = append(packageErrors[path], err)
+		}
+		for _, err := range result.report.Suppressed {
+			path := r.fileSet.Position(err.Pos).Filename
+			packageSuppressed[path] = append(packageSuppressed[path], err)
+		}
+	}
+
+	reports := map[string]*checker.Report{}
+	for path := range r.files {
+		errors := append(fileErrors[path], packageErrors[path]...)
+		sort.SliceStable(errors, func(i, j int) bool {
+			return errors[i].Pos < errors[j].Pos
+		})
+
+		reports[path] = &checker.Report{
+			Errors:     errors,
+			Suppressed: append(suppressedErrors[path], packageSuppressed[path]...),
+		}
+	}
+
+	return reports, nil
+}
+
+// tasks returns the tasks of all files and packages of the run. Files in the
+// same directory share a config, so packages are checked with the config of
+// their directory.
+func (r *checkRun) tasks() ([]checkTask, error) {
+	var tasks []checkTask
+	for path := range r.files {
+		config, err := r.configs.configFor(filepath.Dir(path))
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, checkTask{path: path, config: config})
+	}
+
+	for key, files := range r.packages {
+		config, err := r.configs.configFor(key.dir)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, checkTask{files: files, config: config})
+	}
+
+	return tasks, nil
+}
+
+func (r *checkRun) checkTask(fc *checker.FileChecker, task checkTask) checkResult {
+	report := &checker.Report{}
+
+	if task.files != nil {
+		var contents []string
+		for _, file := range task.files {
+			path := r.fileSet.Position(file.Pos()).Filename
+			contents = append(contents, r.contents[path])
+		}
+
+		fc.CheckPackage(task.files, contents, report)
+	} else {
+		file := r.files[task.path]
+		fc.WithTypes(r.typeInfos[file]).Check(file, r.contents[task.path], report)
+	}
+
+	return checkResult{
+		task:   task,
+		report: report,
+	}
+}
+
+// fix applies the fixes of the violations in `reports` and returns the
+// number of fixed violations. Fixed violations are removed from the reports.
+// If `write` is not set the fixes are printed as a unified diff instead of
+// being written.
+func (r *checkRun) fix(
+	reports map[string]*checker.Report,
+	write bool) (int, error) {
+
+	fixedErrors := 0
+	for _, path := range reportPaths(reports) {
+		report := reports[path]
+
+		fixReport, err := checker.Fix(r.files[path], r.contents[path], report)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fix file: %s: %s", path, err)
+		}
+
+		report.Errors = fixReport.Remaining
+		if len(fixReport.Fixed) == 0 {
+			continue
+		}
+
+		if write {
+			err = writeFile(path, fixReport.Content)
+		} else {
+			err = printDiff(path, r.contents[path], fixReport.Content)
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		fixedErrors += len(fixReport.Fixed)
+	}
+
+	return fixedErrors, nil
+}
+
+// result resolves the positions of the violations in `reports` which were
+// registered by `checkers`.
+func (r *checkRun) result(
+	reports map[string]*checker.Report,
+	checkers map[string]checker.NodeChecker) (*reporter.Result, error) {
+
+	result := &reporter.Result{
+		Checkers: checkers,
+	}
+	for _, path := range reportPaths(reports) {
+		slugs, err := r.checkedBy(path)
+		if err != nil {
+			return nil, err
+		}
+
+		file := reporter.File{
+			Path:     path,
+			Checkers: slugs,
+		}
+
+		for _, err := range reports[path].Errors {
+			file.Violations = append(file.Violations, r.violation(err))
+		}
+
+		for _, err := range reports[path].Suppressed {
+			file.Suppressed = append(file.Suppressed, r.violation(err))
+		}
+
+		result.Files = append(result.Files, file)
+	}
+
+	return result, nil
+}
+
+// checkedBy returns the slugs of the checkers which checked the file at
+// `path` in sorted order. These are the checkers of the config of the file
+// whose matchers accept it.
+func (r *checkRun) checkedBy(path string) ([]string, error) {
+	config, err := r.configs.configFor(filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var slugs []string
+	for slug := range config.Checkers {
+		matchers, err := config.newCheckerMatchers(slug)
+		if err != nil {
+			return nil, err
+		}
+
+		if matchesAll(matchers, path) {
+			slugs = append(slugs, slug)
+		}
+	}
+	sort.Strings(slugs)
+
+	return slugs, nil
+}
+
+// matchesAll reports whether `path` is accepted by all `matchers`.
+func matchesAll(matchers []file.Matcher, path string) bool {
+	for _, matcher := range matchers {
+		if !matcher.Matches(path) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// violation resolves the position of `err`.
+func (r *checkRun) violation(err checker.Error) reporter.Violation {
+	start := r.fileSet.Position(err.Pos)
+	end := start
+	if err.End.IsValid() {
+		end = r.fileSet.Position(err.End)
+	}
+
+	return reporter.Violation{
+		Slug:      err.Slug,
+		Severity:  err.Severity,
+		Line:      start.Line,
+		Column:    start.Column,
+		EndLine:   end.Line,
+		EndColumn: end.Column,
+		Message:   err.Message,
+	}
+}
+
+// restrict restricts the checked files to the packages of the files changed
+// by `d`. All files of a package are checked, so that the package checkers
+// and the types see the complete package. Use applyDiff to restrict the
+// violations to the changed lines.
+func (r *checkRun) restrict(d *diff.Diff) {
+	dirs := packageMatcher{}
+	for _, path := range d.Files() {
+		dirs[filepath.Dir(path)] = struct{}{}
+	}
+
+	r.filter = dirs
+}
+
+// goFileMatcher accepts the Go source files.
+type goFileMatcher struct{}
+
+// Matches implements the file.Matcher interface.
+func (m goFileMatcher) Matches(path string) bool {
+	return filepath.Ext(path) == ".go"
+}
+
+// packageMatcher accepts the files in a set of package directories.
+type packageMatcher map[string]struct{}
+
+// Matches implements the file.Matcher interface.
+func (m packageMatcher) Matches(path string) bool {
+	_, ok := m[filepath.Dir(path)]
+	return ok
+}
+
+// applyDiff removes the violations which are not on lines added or modified
+// by `d` from `reports`.
+func (r *checkRun) applyDiff(
+	reports map[string]*checker.Report,
+	d *diff.Diff) {
+
+	for path, report := range reports {
+		var errors []checker.Error
+		for _, err := range report.Errors {
+			start := r.fileSet.Position(err.Pos)
+			end := start
+			if err.End.IsValid() {
+				end = r.fileSet.Position(err.End)
+			}
+
+			if d.Overlaps(path, start.Line, end.Line) {
+				errors = append(errors, err)
+			}
+		}
+		report.Errors = errors
+	}
+}
+
+// baselineViolation identifies `err` in the file at `path` for a baseline.
+func (r *checkRun) baselineViolation(
+	path string,
+	err checker.Error) baseline.Violation {
+
+	violation := baseline.Violation{
+		Slug:    err.Slug,
+		Path:    path,
+		Message: err.Message,
+	}
+
+	if err.Pos.IsValid() {
+		content := r.contents[path]
+		offset := r.fileSet.Position(err.Pos).Offset
+
+		start := strings.LastIndex(content[:offset], "\n") + 1
+		end := len(content)
+		if index := strings.Index(content[offset:], "\n"); index >= 0 {
+			end = offset + index
+		}
+
+		violation.Line = content[start:end]
+	}
+
+	return violation
+}
+
+// applyBaseline removes the violations recorded in `b` from `reports`.
+func (r *checkRun) applyBaseline(
+	reports map[string]*checker.Report,
+	b *baseline.Baseline) {
+
+	for _, path := range reportPaths(reports) {
+		report := reports[path]
+
+		var errors []checker.Error
+		for _, err := range report.Errors {
+			if !b.Match(r.baselineViolation(path, err)) {
+				errors = append(errors, err)
+			}
+		}
+		report.Errors = errors
+	}
+}
+
+// reportPaths returns the paths of all files in `reports` in sorted order.
+func reportPaths(reports map[string]*checker.Report) []string {
+	var paths []string
+	for path := range reports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+// writeFile replaces the content of the file at `path` keeping its mode.
+func writeFile(path, content string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to write file: %s", path)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(content), info.Mode()); err != nil {
+		return fmt.Errorf("failed to write file: %s", path)
+	}
+
+	return nil
+}
+
+// printDiff prints the changes of the file at `path` as a unified diff.
+func printDiff(path, before, after string) error {
+	// Paths are relative to the working directory when possible so that the
+	// diff can be applied with git apply.
+	diffPath := path
+	if wd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(wd, path); err == nil {
+			diffPath = filepath.ToSlash(rel)
+		}
+	}
+
+	diff := difflib.UnifiedDiff{
+		A:        splitLines(before),
+		B:        splitLines(after),
+		FromFile: "a/" + diffPath,
+		ToFile:   "b/" + diffPath,
+		Context:  3,
+	}
+
+	if err := difflib.WriteUnifiedDiff(os.Stdout, diff); err != nil {
+		return fmt.Errorf("failed to print diff: %s", path)
+	}
+
+	return nil
+}
+
+// splitLines splits `content` into lines keeping their line endings.
+func splitLines(content string) []string {
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
@@ -0,0 +1,222 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/s2gatev/lingo/diff"
+	"github.com/stretchr/testify/assert"
+)
+
+// checkRunConfig enables a file checker and a package checker.
+const checkRunConfig = `
+matchers:
+  -
+    type: 'glob'
+    config:
+      pattern: '**/*.go'
+
+checkers:
+  multi_word_ident_name:
+  consistent_receiver_names:
+`
+
+func TestCheckRunCheckDir(t *testing.T) {
+	files := map[string]string{
+		"lingo.yml": checkRunConfig,
+		"a/a.go":    "package a\n\ntype T struct{}\n\nfunc (t T) Foo() {}\n",
+		"a/b.go": "package a\n\nvar foo_bar = 1\n\n" +
+			"func (x T) Bar() {}\n\nvar bar_baz = 1\n",
+	}
+	expected := []string{
+		"a/b.go:3 multi_word_ident_name",
+		"a/b.go:5 consistent_receiver_names",
+		"a/b.go:7 multi_word_ident_name",
+	}
+	for i := 0; i < 20; i++ {
+		path := fmt.Sprintf("c/c%02d.go", i)
+		files[path] = "package c\n\nvar foo_bar = 1\n"
+		expected = append(expected, path+":3 multi_word_ident_name")
+	}
+
+	dir := writeTree(t, files)
+	defer os.RemoveAll(dir)
+	defer chdir(t, dir)()
+
+	defaultJobs := jobs
+	defer func() {
+		jobs = defaultJobs
+	}()
+
+	for _, jobCount := range []int{1, 2, 8, 32} {
+		t.Run(fmt.Sprintf("%d jobs", jobCount), func(t *testing.T) {
+			jobs = jobCount
+
+			config, err := loadConfig("lingo.yml")
+			assert.NoError(t, err)
+
+			run := newCheckRun()
+			reports, err := run.checkDir(config, "./...")
+			assert.NoError(t, err)
+			assert.Len(t, reports, 22)
+
+			checkers, err := run.configs.newCheckers()
+			assert.NoError(t, err)
+
+			result, err := run.result(reports, checkers)
+			assert.NoError(t, err)
+
+			var violations []string
+			for _, file := range result.Files {
+				path, err := filepath.Rel(dir, file.Path)
+				assert.NoError(t, err)
+
+				for _, violation := range file.Violations {
+					violations = append(violations, fmt.Sprintf("%s:%d %s",
+						filepath.ToSlash(path), violation.Line, violation.Slug))
+				}
+			}
+			assert.Equal(t, expected, violations)
+		})
+	}
+}
+
+func TestCheckRunSuppressedPackageErrors(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml": checkRunConfig,
+		"a.go":      "package a\n\ntype T struct{}\n\nfunc (t T) Foo() {}\n",
+		"b.go": "package a\n\n" +
+			"//lingo:ignore consistent_receiver_names\nfunc (x T) Bar() {}\n",
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, dir)()
+
+	config, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	run := newCheckRun()
+	reports, err := run.checkDir(config, ".")
+	assert.NoError(t, err)
+
+	report := reports[filepath.Join(dir, "b.go")]
+	if assert.NotNil(t, report) {
+		assert.Empty(t, report.Errors)
+		if assert.Len(t, report.Suppressed, 1) {
+			assert.Equal(t, "consistent_receiver_names", report.Suppressed[0].Slug)
+		}
+	}
+}
+
+func TestCheckRunRestrict(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml": checkRunConfig,
+		"a/a.go": "package a\n\nvar foo_bar = 1\n\n" +
+			"type Server struct{}\n\nfunc (s *Server) A() {}\n",
+		"a/b.go": "package a\n\nfunc (z *Server) D() {}\n",
+		"c/c.go": "package c\n\nvar foo_bar = 1\n",
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, dir)()
+
+	d, err := diff.Parse(strings.NewReader(`diff --git a/a/b.go b/a/b.go
+new file mode 100644
+--- /dev/null
++++ b/a/b.go
+@@ -0,0 +1,3 @@
++package a
++
++func (z *Server) D() {}
+`), dir)
+	assert.NoError(t, err)
+
+	config, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	run := newCheckRun()
+	run.restrict(d)
+	reports, err := run.checkDir(config, "./...")
+	assert.NoError(t, err)
+	run.applyDiff(reports, d)
+
+	var violations []string
+	for _, path := range reportPaths(reports) {
+		for _, err := range reports[path].Errors {
+			violations = append(violations, fmt.Sprintf("%s:%d %s",
+				filepath.Base(path), run.fileSet.Position(err.Pos).Line, err.Slug))
+		}
+	}
+	assert.Equal(t, []string{"b.go:3 consistent_receiver_names"}, violations)
+	assert.Len(t, reports, 2)
+}
+
+func TestCheckRunCheckedBy(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml": `
+matchers:
+  -
+    type: 'glob'
+    config:
+      pattern: '**/*.go'
+
+checkers:
+  multi_word_ident_name:
+  exported_ident_doc:
+    matchers:
+      -
+        type: 'not'
+        config:
+          type: 'glob'
+          config:
+            pattern: '**/*_test.go'
+`,
+		"foo.go":      "package foo\n",
+		"foo_test.go": "package foo\n",
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, dir)()
+
+	config, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	run := newCheckRun()
+	reports, err := run.checkDir(config, ".")
+	assert.NoError(t, err)
+
+	checkers, err := run.configs.newCheckers()
+	assert.NoError(t, err)
+
+	result, err := run.result(reports, checkers)
+	assert.NoError(t, err)
+	if assert.Len(t, result.Files, 2) {
+		assert.Equal(t,
+			[]string{"exported_ident_doc", "multi_word_ident_name"},
+			result.Files[0].Checkers)
+		assert.Equal(t, []string{"multi_word_ident_name"}, result.Files[1].Checkers)
+	}
+}
+
+func TestCheckRunGoFiles(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml":      "checkers:\n  multi_word_ident_name:\n",
+		"a.go":           "package a\n\nvar foo_bar = 1\n",
+		"README.md":      "# a\n",
+		"b/b.go":         "package b\n",
+		"b/testdata/b.c": "int b;\n",
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, dir)()
+
+	config, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	run := newCheckRun()
+	reports, err := run.checkDir(config, "./...")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(dir, "a.go"),
+		filepath.Join(dir, "b", "b.go"),
+	}, reportPaths(reports))
+}
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// severityConfig configures a checker of every severity.
+const severityConfig = `
+matchers:
+  -
+    type: 'glob'
+    config:
+      pattern: '**/*.go'
+
+checkers:
+  multi_word_ident_name:
+  line_length:
+    max_length: 20
+    severity: warning
+  exported_ident_doc:
+    severity: info
+`
+
+// The sources below violate only the checker of their severity.
+const (
+	errorSource   = "package foo\n\nvar foo_bar = 1\n"
+	warningSource = "package foo\n\nvar fooBarBazQuxQuux = 1\n"
+	infoSource    = "package foo\n\nvar Foo = 1\n"
+	validSource   = "package foo\n\nvar foo = 1\n"
+)
+
+func TestCheckFailOn(t *testing.T) {
+	tests := []struct {
+		description string
+		source      string
+		failOn      string
+		expected    int
+	}{
+		{
+			description: "error failing on error",
+			source:      errorSource,
+			failOn:      "error",
+			expected:    1,
+		},
+		{
+			description: "warning failing on error",
+			source:      warningSource,
+			failOn:      "error",
+			expected:    0,
+		},
+		{
+			description: "info failing on error",
+			source:      infoSource,
+			failOn:      "error",
+			expected:    0,
+		},
+		{
+			description: "error failing on warning",
+			source:      errorSource,
+			failOn:      "warning",
+			expected:    1,
+		},
+		{
+			description: "warning failing on warning",
+			source:      warningSource,
+			failOn:      "warning",
+			expected:    1,
+		},
+		{
+			description: "info failing on warning",
+			source:      infoSource,
+			failOn:      "warning",
+			expected:    0,
+		},
+		{
+			description: "info failing on info",
+			source:      infoSource,
+			failOn:      "info",
+			expected:    1,
+		},
+		{
+			description: "no violations failing on info",
+			source:      validSource,
+			failOn:      "info",
+			expected:    0,
+		},
+		{
+			description: "invalid severity",
+			source:      validSource,
+			failOn:      "fatal",
+			expected:    1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := writeTree(t, map[string]string{
+				"lingo.yml": severityConfig,
+				"foo.go":    test.source,
+			})
+			defer os.RemoveAll(dir)
+
+			for _, format := range []string{"text", "json"} {
+				_, code := runLingo(t, dir,
+					"check", "--fail-on", test.failOn, "--format", format, "./...")
+				assert.Equal(t, test.expected, code, format)
+			}
+		})
+	}
+}
+
+func TestCheckSeverities(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml":  severityConfig,
+		"error.go":   errorSource,
+		"warning.go": warningSource,
+		"info.go":    infoSource,
+	})
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		format   string
+		expected []string
+	}{
+		{
+			format: "text",
+			expected: []string{
+				"error.go:3:5: error: name 'foo_bar' is not valid",
+				"warning.go:3:1: warning: line is too long",
+				"info.go:3:5: info: exported identifier 'Foo' is not documented",
+			},
+		},
+		{
+			format: "json",
+			expected: []string{
+				`"severity": "error"`,
+				`"severity": "warning"`,
+				`"severity": "info"`,
+			},
+		},
+		{
+			format: "sarif",
+			expected: []string{
+				`"level": "error"`,
+				`"level": "warning"`,
+				`"level": "note"`,
+			},
+		},
+		{
+			format: "checkstyle",
+			expected: []string{
+				`severity="error"`,
+				`severity="warning"`,
+				`severity="info"`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			output, _ := runLingo(t, dir, "check", "--format", test.format, "./...")
+			for _, expected := range test.expected {
+				assert.Contains(t, output, expected)
+			}
+		})
+	}
+}
+
+// fixConfig enables a checker with fixes and a checker without fixes.
+const fixConfig = `
+matchers:
+  -
+    type: 'glob'
+    config:
+      pattern: '**/*.go'
+
+checkers:
+  multi_word_ident_name:
+  redundant_else:
+`
+
+func TestCheckFix(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml": fixConfig,
+		"foo.go": "package foo\n\nfunc foo(x int) int {\n\tif x > 0 {\n" +
+			"\t\treturn 1\n\t} else {\n\t\treturn 2\n\t}\n}\n\nvar foo_bar = 1\n",
+	})
+	defer os.RemoveAll(dir)
+
+	output, code := runLingo(t, dir, "check", "--fix", "./...")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, output, "foo.go:10:5: error: name 'foo_bar' is not valid")
+	assert.Contains(t, output, "1 violations found in 1 files")
+	assert.NotContains(t, output, "redundant_else")
+}
+
+func TestCheckConfigFlag(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml":     fixConfig,
+		"foo/lingo.yml": "checkers: [\n",
+		"foo/foo.go":    validSource,
+	})
+	defer os.RemoveAll(dir)
+
+	output, code := runLingo(t, dir, "check", "./...")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, output, "failed to parse config file")
+
+	output, code = runLingo(t, dir, "check", "--config", "lingo.yml", "./...")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, output, "0 violations found in 1 files")
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/s2gatev/lingo/checker"
+	"github.com/s2gatev/lingo/cli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	Checkers.PersistentFlags().StringVar(
+		&configFile, "config", defaultConfigFilename,
+		"config file with the options of the checkers, if it exists")
+	Checkers.PersistentFlags().StringVar(
+		&checkersFormat, "format", defaultFormat, "output format: text, json, markdown")
+	Checkers.PersistentFlags().StringVar(
+		&checkersOutput, "output", "", "output file instead of the standard output")
+	Checkers.PersistentFlags().BoolVar(
+		&checkersDefaults, "defaults", false,
+		"describe the checkers with their default options, ignoring the config file")
+
+	Root.AddCommand(Checkers)
+}
+
+// Checkers is a command handler that lists all registered checkers and
+// their configuration options.
+var Checkers = &cobra.Command{
+	Use:   "checkers",
+	Short: "List all checkers and their options",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		write, ok := checkersWriters[checkersFormat]
+		if !ok {
+			cli.ExitError("unknown format: %s", checkersFormat)
+		}
+
+		config := &Config{}
+		if !checkersDefaults {
+			var err error
+			config, err = loadCheckersConfig(cmd)
+			if err != nil {
+				cli.ExitError("%s", err)
+			}
+		}
+
+		var infos []checkerInfo
+		for _, slug := range checker.Slugs() {
+			c, err := config.newChecker(slug)
+			if err != nil {
+				cli.ExitError("%s", err)
+			}
+
+			infos = append(infos, newCheckerInfo(slug, c))
+		}
+
+		output := os.Stdout
+		if checkersOutput != "" {
+			var err error
+			output, err = os.Create(checkersOutput)
+			if err != nil {
+				cli.ExitError("failed to create output file: %s", checkersOutput)
+			}
+			defer output.Close()
+		}
+
+		w := bufio.NewWriter(output)
+		if err := write(w, infos); err != nil {
+			cli.ExitError("failed to write checkers: %s", err)
+		}
+		if err := w.Flush(); err != nil {
+			cli.ExitError("failed to write checkers: %s", err)
+		}
+	},
+}
+
+// checkerInfo describes a registered checker.
+type checkerInfo struct {
+
+	// Slug is the slug of the checker.
+	Slug string `json:"slug"`
+
+	// Title is the title of the checker.
+	Title string `json:"title"`
+
+	// Description is the description of the checker.
+	Description string `json:"description"`
+
+	// Options contains the configuration options of the checker.
+	Options []checker.Option `json:"options"`
+
+	// Examples contains the examples of the checker without indentation.
+	Examples []guideItemExample `json:"examples"`
+}
+
+func newCheckerInfo(slug string, c checker.NodeChecker) checkerInfo {
+	info := checkerInfo{
+		Slug:        slug,
+		Title:       c.Title(),
+		Description: c.Description(),
+		Options:     checker.Options(slug),
+		Examples:    []guideItemExample{},
+	}
+	if info.Options == nil {
+		info.Options = []checker.Option{}
+	}
+
+	for _, example := range c.Examples() {
+		info.Examples = append(info.Examples, guideItemExample{
+			Good: checker.Dedent(example.Good),
+			Bad:  checker.Dedent(example.Bad),
+		})
+	}
+
+	return info
+}
+
+// checkersWriters contains the functions which write the list of checkers
+// mapped by the slugs of their formats.
+var checkersWriters = map[string]func(io.Writer, []checkerInfo) error{
+	"text":     writeCheckersText,
+	"json":     writeCheckersJSON,
+	"markdown": writeCheckersMarkdown,
+}
+
+func writeCheckersText(w io.Writer, infos []checkerInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for i, info := range infos {
+		if i > 0 {
+			fmt.Fprintln(tw)
+		}
+
+		fmt.Fprintf(tw, "%s - %s\n", info.Slug, info.Title)
+		fmt.Fprintf(tw, "  %s\n", info.Description)
+		for _, option := range info.Options {
+			fmt.Fprintf(tw, "  %s: %s\t%s\n",
+				option.Name, option.Type, option.Description)
+		}
+	}
+
+	return tw.Flush()
+}
+
+func writeCheckersJSON(w io.Writer, infos []checkerInfo) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(infos)
+}
+
+func writeCheckersMarkdown(w io.Writer, infos []checkerInfo) error {
+	fmt.Fprintf(w, "<!-- Code generated by lingo checkers. DO NOT EDIT. -->\n\n")
+	fmt.Fprintf(w, "# Checkers\n")
+
+	for _, info := range infos {
+		fmt.Fprintf(w, "\n## %s\n\n", info.Slug)
+		fmt.Fprintf(w, "**%s**\n\n", info.Title)
+		fmt.Fprintf(w, "%s\n", info.Description)
+
+		if len(info.Options) > 0 {
+			fmt.Fprintf(w, "\nAvailable options:\n")
+		}
+		for _, option := range info.Options {
+			fmt.Fprintf(w, "* `%s: %s` - %s.\n",
+				option.Name, option.Type, option.Description)
+		}
+
+		for _, example := range info.Examples {
+			fmt.Fprintf(w, "\nGood:\n\n```go\n%s\n```\n", example.Good)
+			fmt.Fprintf(w, "\nBad:\n\n```go\n%s\n```\n", example.Bad)
+		}
+	}
+
+	return nil
+}
+
+var checkersFormat string
+
+var checkersOutput string
+
+var checkersDefaults bool
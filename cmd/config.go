@@ -1,19 +1,413 @@
 package cmd
 
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/s2gatev/lingo/checker"
+	"github.com/s2gatev/lingo/file"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
 // Config describes the lingo check config file structure.
 type Config struct {
+
+	// Extends is a list of config files which are merged into the config.
+	// Relative paths are resolved against the directory of the config file.
+	Extends []string `yaml:"extends"`
+
 	// Matchers is a list of file matchers used to define
 	// the files that will be checked.
-	Matchers []struct {
-		Type   string                 `yaml:"type"`
-		Config map[string]interface{} `yaml:"config"`
-	} `yaml:"matchers"`
+	Matchers []MatcherConfig `yaml:"matchers"`
 
 	// Checkers is a map[checker_slug]checker_config of checkers
 	// that need to be executed.
-	Checkers map[string]map[string]interface{} `yaml:"checkers"`
+	Checkers map[string]CheckerConfig `yaml:"checkers"`
+
+	// Suppression configures how violations are suppressed with
+	// //lingo:ignore and //lingo:file-ignore comments.
+	Suppression struct {
+
+		// RequireReason signals if suppression comments must state
+		// a reason.
+		RequireReason bool `yaml:"require_reason"`
+	} `yaml:"suppression"`
+
+	// path is the path of the config file.
+	path string
+
+	// data is the content of the config file.
+	data []byte
+}
+
+// MatcherConfig is the config of a file matcher in the config file.
+type MatcherConfig struct {
+
+	// Type is the slug of the matcher.
+	Type string `yaml:"type"`
+
+	// Config is the configuration of the matcher.
+	Config map[string]interface{} `yaml:"config"`
+}
+
+// CheckerConfig is the config of a checker in the config file.
+type CheckerConfig struct {
+
+	// Options contains the options of the checker. It is nil if the
+	// checker is set to null in the config file.
+	Options map[string]interface{}
+
+	// Matchers is a list of file matchers which restrict the files checked
+	// by the checker.
+	Matchers []MatcherConfig
+
+	// Severity is the name of the severity of the violations of the checker.
+	// The checker decides the severity if it is empty.
+	Severity string
+
+	// Disabled signals if the checker is set to `disabled` in the config
+	// file.
+	Disabled bool
+
+	// source is the config file which configures the checker.
+	source *Config
+}
+
+// disabledChecker is the value which turns off a checker in the config file.
+const disabledChecker = "disabled"
+
+// UnmarshalYAML parses either the options, the matchers and the severity of
+// a checker or `disabled`.
+func (c *CheckerConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value string
+	if err := unmarshal(&value); err == nil {
+		if value != disabledChecker {
+			return fmt.Errorf("invalid checker config %q, expected options or %s",
+				value, disabledChecker)
+		}
+
+		c.Disabled = true
+		return nil
+	}
+
+	var config struct {
+
+		// Matchers is the list of file matchers of the checker.
+		Matchers []MatcherConfig `yaml:"matchers"`
+
+		// Severity is the severity of the checker.
+		Severity string `yaml:"severity"`
+
+		// Options contains all other keys, i.e. the options of the checker.
+		Options map[string]interface{} `yaml:",inline"`
+	}
+	if err := unmarshal(&config); err != nil {
+		return err
+	}
+
+	c.Options = config.Options
+	if c.Options == nil {
+		c.Options = map[string]interface{}{}
+	}
+	c.Matchers = config.Matchers
+	c.Severity = config.Severity
+
+	return nil
+}
+
+// equal reports whether the checker is configured the same way by `other`.
+func (c CheckerConfig) equal(other CheckerConfig) bool {
+	return c.Disabled == other.Disabled &&
+		c.Severity == other.Severity &&
+		reflect.DeepEqual(c.Options, other.Options) &&
+		reflect.DeepEqual(c.Matchers, other.Matchers)
 }
 
 const defaultConfigFilename = "lingo.yml"
 
 var configFile string
+
+// loadConfig reads and parses the config file at `path` and merges it with
+// the config files it extends. Unknown fields and values of a wrong type are
+// rejected.
+func loadConfig(path string) (*Config, error) {
+	return loadExtendedConfig(path, nil)
+}
+
+// readConfig reads and parses the config file at `path` without the config
+// files it extends.
+func readConfig(path string) (*Config, error) {
+	configData, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %s", path)
+	}
+
+	// Config files in other formats are converted to YAML, so the lines of
+	// their errors are not known.
+	convert, converted := configConverters[filepath.Ext(path)]
+	if converted {
+		configData, err = convert(configData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse config file: %s: %s", path, err)
+		}
+	}
+
+	var config Config
+	if err := yaml.UnmarshalStrict(configData, &config); err != nil {
+		return nil, parseError(path, err, !converted)
+	}
+
+	config.path = path
+	if !converted {
+		config.data = configData
+	}
+	for slug, checkerConfig := range config.Checkers {
+		checkerConfig.source = &config
+		config.Checkers[slug] = checkerConfig
+	}
+
+	return &config, nil
+}
+
+var (
+	yamlErrorLine  = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+	yamlErrorField = regexp.MustCompile(`^field (\S+) not found in type \S+$`)
+)
+
+// parseError formats the YAML error `err` of the config file at `path`
+// with the line numbers of the errors if `hasLines` is set.
+func parseError(path string, err error, hasLines bool) error {
+	messages := []string{err.Error()}
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		messages = typeErr.Errors
+	}
+
+	var lines []string
+	for _, message := range messages {
+		position := path
+		if match := yamlErrorLine.FindStringSubmatch(message); match != nil {
+			message = yamlErrorField.ReplaceAllString(match[2], "unknown field: $1")
+			if hasLines {
+				position = fmt.Sprintf("%s:%s", path, match[1])
+			}
+		}
+
+		lines = append(lines, fmt.Sprintf("%s: %s", position, message))
+	}
+
+	return fmt.Errorf("failed to parse config file: %s", strings.Join(lines, "\n"))
+}
+
+// loadCheckersConfig reads and validates the config file found from the
+// working directory. The config file is required only if it is set with the
+// --config flag of `cmd`, otherwise an empty config is returned if there is
+// no config file.
+func loadCheckersConfig(cmd *cobra.Command) (*Config, error) {
+	resolveConfigFile(cmd, ".")
+
+	_, err := os.Stat(configFile)
+	if os.IsNotExist(err) && !cmd.Flags().Changed("config") {
+		return &Config{}, nil
+	}
+
+	config, err := loadConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if errs := config.validate(); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return config, nil
+}
+
+// newMatchers creates new instances of all matchers in the config.
+func (c *Config) newMatchers() ([]file.Matcher, error) {
+	return newMatchers(c.Matchers)
+}
+
+// newMatchers creates new instances of the matchers in `configs`.
+func newMatchers(configs []MatcherConfig) ([]file.Matcher, error) {
+	var matchers []file.Matcher
+	for _, matcher := range configs {
+		m := file.Get(matcher.Type, matcher.Config)
+		if m == nil {
+			return nil, fmt.Errorf("unknown matcher: %s", matcher.Type)
+		}
+
+		matchers = append(matchers, m)
+	}
+
+	return matchers, nil
+}
+
+// newChecker creates a new instance of the checker referenced by `slug`
+// with its config. Errors in the config are reported with their position in
+// the config file.
+func (c *Config) newChecker(slug string) (checker.NodeChecker, error) {
+	nc, err := checker.Get(slug, c.Checkers[slug].Options)
+	if err == nil {
+		return nc, nil
+	}
+
+	errs := c.checkerConfigErrors(slug, err)
+	if len(errs) == 1 {
+		return nil, errs[0]
+	}
+
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+
+	return nil, errors.New(strings.Join(messages, "\n"))
+}
+
+// checkerConfigErrors returns every error in `err`, an error in the config
+// of the checker referenced by `slug`, with its position in the config file.
+func (c *Config) checkerConfigErrors(slug string, err error) []error {
+	switch configErr := err.(type) {
+	case *checker.ConfigError:
+		return []error{c.checkerError(slug, configErr.Option, configErr)}
+	case checker.ConfigErrors:
+		errs := make([]error, len(configErr))
+		for i, optionErr := range configErr {
+			errs[i] = c.checkerError(slug, optionErr.Option, optionErr)
+		}
+		return errs
+	default:
+		return []error{c.checkerError(slug, "", err)}
+	}
+}
+
+// newCheckerMatchers creates new instances of the matchers which restrict
+// the files checked by the checker referenced by `slug`.
+func (c *Config) newCheckerMatchers(slug string) ([]file.Matcher, error) {
+	matchers, err := newMatchers(c.Checkers[slug].Matchers)
+	if err != nil {
+		return nil, c.checkerError(slug, "matchers", err)
+	}
+
+	return matchers, nil
+}
+
+// checkerSeverity returns the severity configured for the checker referenced
+// by `slug`.
+func (c *Config) checkerSeverity(slug string) (checker.Severity, error) {
+	severity, err := checker.ParseSeverity(c.Checkers[slug].Severity)
+	if err != nil {
+		return severity, c.checkerError(slug, "severity", err)
+	}
+
+	return severity, nil
+}
+
+// checkerError returns `err` of the checker referenced by `slug` with the
+// position of the checker or its `option` in the config file.
+func (c *Config) checkerError(slug, option string, err error) error {
+	source := c.Checkers[slug].source
+	if source == nil {
+		return err
+	}
+
+	keys := []string{"checkers", slug}
+	if option != "" {
+		keys = append(keys, option)
+	}
+
+	if line := yamlLine(source.data, keys); line > 0 {
+		return fmt.Errorf("%s:%d: %s", source.path, line, err)
+	}
+
+	return fmt.Errorf("%s: %s", source.path, err)
+}
+
+// validate returns all errors in the config.
+func (c *Config) validate() []error {
+	var errs []error
+	if _, err := c.newMatchers(); err != nil {
+		errs = append(errs, fmt.Errorf("%s: %s", c.path, err))
+	}
+
+	var slugs []string
+	for slug := range c.Checkers {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	for _, slug := range slugs {
+		if _, err := checker.Get(slug, c.Checkers[slug].Options); err != nil {
+			errs = append(errs, c.checkerConfigErrors(slug, err)...)
+		}
+		if _, err := c.newCheckerMatchers(slug); err != nil {
+			errs = append(errs, err)
+		}
+		if c.Checkers[slug].Severity == "" {
+			continue
+		}
+		if _, err := c.checkerSeverity(slug); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+var yamlKey = regexp.MustCompile(
+	`^(?:'([^']*)'|"([^"]*)"|([^\s'"#:-][^:#]*?))\s*:(?:\s|$)`)
+
+// yamlLine returns the line of the mapping key at the path `keys` in the
+// YAML document `data` or 0 if there is no such key. Only block mappings
+// are supported, which is the style of lingo config files.
+func yamlLine(data []byte, keys []string) int {
+	type level struct {
+		indent int
+		key    string
+	}
+
+	var levels []level
+	for i, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		indent := len(line) - len(trimmed)
+		for len(levels) > 0 && levels[len(levels)-1].indent >= indent {
+			levels = levels[:len(levels)-1]
+		}
+
+		match := yamlKey.FindStringSubmatch(trimmed)
+		if match == nil {
+			continue
+		}
+
+		levels = append(levels, level{
+			indent: indent,
+			key:    match[1] + match[2] + match[3],
+		})
+
+		if len(levels) != len(keys) {
+			continue
+		}
+
+		found := true
+		for j, key := range keys {
+			found = found && levels[j].key == key
+		}
+		if found {
+			return i + 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// loadExtendedConfig reads the config file at `path` and merges it with the
+// config files it extends. `chain` contains the config files which extend
+// the config file at `path` and is used to detect cycles.
+func loadExtendedConfig(path string, chain []string) (*Config, error) {
+	if err := checkExtendsCycle(path, chain); err != nil {
+		return nil, err
+	}
+
+	config, err := readConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	chain = append(chain[:len(chain):len(chain)], path)
+
+	base := &Config{Checkers: map[string]CheckerConfig{}}
+	for _, extended := range config.Extends {
+		if !filepath.IsAbs(extended) {
+			extended = filepath.Join(filepath.Dir(path), extended)
+		}
+
+		parent, err := loadExtendedConfig(extended, chain)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := base.inherit(parent, config); err != nil {
+			return nil, err
+		}
+	}
+
+	config.extend(base)
+
+	return config, nil
+}
+
+// checkExtendsCycle returns an error if the config file at `path` is already
+// in the `chain` of config files which extend each other.
+func checkExtendsCycle(path string, chain []string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve config file: %s", path)
+	}
+
+	for i, extending := range chain {
+		absExtending, err := filepath.Abs(extending)
+		if err == nil && absExtending == absPath {
+			cycle := append(chain[i:len(chain):len(chain)], path)
+			return fmt.Errorf("config files extend each other: %s",
+				strings.Join(cycle, " -> "))
+		}
+	}
+
+	return nil
+}
+
+// inherit merges the `parent` config extended by `config` into the base
+// config. Checkers which are configured differently by two extended config
+// files must be configured by `config` itself.
+func (c *Config) inherit(parent, config *Config) error {
+	for slug, checkerConfig := range parent.Checkers {
+		inherited, ok := c.Checkers[slug]
+		_, overridden := config.Checkers[slug]
+		if ok && !overridden && !inherited.equal(checkerConfig) {
+			return fmt.Errorf(
+				"%s: checker %s is configured differently in %s and %s",
+				config.path, slug, inherited.source.path, checkerConfig.source.path)
+		}
+
+		c.Checkers[slug] = checkerConfig
+	}
+
+	c.Matchers = append(c.Matchers, parent.Matchers...)
+	c.Suppression.RequireReason =
+		c.Suppression.RequireReason || parent.Suppression.RequireReason
+
+	return nil
+}
+
+// extend merges the config with the `base` config of the config files it
+// extends. The checkers of the config override the inherited ones, while
+// null or `disabled` turns off an inherited checker. The matchers of the
+// config are appended to the inherited ones.
+func (c *Config) extend(base *Config) {
+	for slug, checkerConfig := range c.Checkers {
+		_, inherited := base.Checkers[slug]
+		if checkerConfig.Disabled || inherited && checkerConfig.Options == nil {
+			delete(base.Checkers, slug)
+			continue
+		}
+
+		base.Checkers[slug] = checkerConfig
+	}
+
+	c.Checkers = base.Checkers
+	c.Matchers = append(base.Matchers, c.Matchers...)
+	c.Suppression.RequireReason =
+		c.Suppression.RequireReason || base.Suppression.RequireReason
+}
@@ -0,0 +1,221 @@
+package cmd
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadExtendedConfig(t *testing.T) {
+	tests := []struct {
+		description   string
+		files         map[string]string
+		checkers      map[string]map[string]interface{}
+		matchers      []string
+		requireReason bool
+		err           string
+	}{
+		{
+			description: "override inherited options",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n" +
+					"checkers:\n  line_length:\n    max_length: 120\n",
+				"base.yml": "checkers:\n  line_length:\n    max_length: 100\n" +
+					"    tab_width: 2\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"line_length": {"max_length": 120},
+			},
+		},
+		{
+			description: "inherit checkers",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n" +
+					"checkers:\n  func_cyclo:\n    max: 12\n",
+				"base.yml": "checkers:\n  local_return:\n" +
+					"  line_length:\n    max_length: 100\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"func_cyclo":   {"max": 12},
+				"local_return": nil,
+				"line_length":  {"max_length": 100},
+			},
+		},
+		{
+			description: "null disables an inherited checker",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n" +
+					"checkers:\n  line_length:\n  func_cyclo:\n",
+				"base.yml": "checkers:\n  local_return:\n" +
+					"  line_length:\n    max_length: 100\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"func_cyclo":   nil,
+				"local_return": nil,
+			},
+		},
+		{
+			description: "disabled turns off a checker",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n" +
+					"checkers:\n  line_length: disabled\n  func_cyclo: disabled\n",
+				"base.yml": "checkers:\n  local_return:\n" +
+					"  line_length:\n    max_length: 100\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"local_return": nil,
+			},
+		},
+		{
+			description: "empty options reset inherited options",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n" +
+					"checkers:\n  line_length: {}\n",
+				"base.yml": "checkers:\n  line_length:\n    max_length: 100\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"line_length": {},
+			},
+		},
+		{
+			description: "extended config files relative to the extending one",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - shared/base.yml\n",
+				"shared/base.yml": "extends:\n  - strict.yml\n" +
+					"checkers:\n  local_return:\n",
+				"shared/strict.yml": "checkers:\n  func_cyclo:\n    max: 5\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"local_return": nil,
+				"func_cyclo":   {"max": 5},
+			},
+		},
+		{
+			description: "later extended config files override earlier ones",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n  - strict.yml\n" +
+					"checkers:\n  func_cyclo:\n    max: 8\n",
+				"base.yml":   "checkers:\n  func_cyclo:\n    max: 15\n",
+				"strict.yml": "checkers:\n  func_cyclo:\n    max: 5\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"func_cyclo": {"max": 8},
+			},
+		},
+		{
+			description: "matchers appended to inherited matchers",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n" +
+					"matchers:\n  -\n    type: 'not'\n    config:\n" +
+					"      type: 'glob'\n      config:\n" +
+					"        pattern: '**/*_test.go'\n",
+				"base.yml": "matchers:\n  -\n    type: 'glob'\n    config:\n" +
+					"      pattern: '**/*.go'\n",
+			},
+			checkers: map[string]map[string]interface{}{},
+			matchers: []string{"glob", "not"},
+		},
+		{
+			description: "required reason inherited",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - base.yml\n" +
+					"suppression:\n  require_reason: false\n",
+				"base.yml": "suppression:\n  require_reason: true\n",
+			},
+			checkers:      map[string]map[string]interface{}{},
+			requireReason: true,
+		},
+		{
+			description: "checker configured equally by extended config files",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - a.yml\n  - b.yml\n",
+				"a.yml":     "checkers:\n  func_cyclo:\n    max: 5\n",
+				"b.yml":     "checkers:\n  func_cyclo:\n    max: 5\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"func_cyclo": {"max": 5},
+			},
+		},
+		{
+			description: "conflict of extended config files",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - a.yml\n  - b.yml\n",
+				"a.yml":     "checkers:\n  func_cyclo:\n    max: 5\n",
+				"b.yml":     "checkers:\n  func_cyclo:\n    max: 10\n",
+			},
+			err: "lingo.yml: checker func_cyclo is configured differently " +
+				"in a.yml and b.yml",
+		},
+		{
+			description: "conflict resolved by the extending config file",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - a.yml\n  - b.yml\n" +
+					"checkers:\n  func_cyclo:\n    max: 8\n",
+				"a.yml": "checkers:\n  func_cyclo:\n    max: 5\n",
+				"b.yml": "checkers:\n  func_cyclo:\n    max: 10\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"func_cyclo": {"max": 8},
+			},
+		},
+		{
+			description: "config file extending itself",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - lingo.yml\n",
+			},
+			err: "config files extend each other: lingo.yml -> lingo.yml",
+		},
+		{
+			description: "cycle of config files",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - a.yml\n",
+				"a.yml":     "extends:\n  - b.yml\n",
+				"b.yml":     "extends:\n  - a.yml\n",
+			},
+			err: "config files extend each other: a.yml -> b.yml -> a.yml",
+		},
+		{
+			description: "config file extended twice without a cycle",
+			files: map[string]string{
+				"lingo.yml": "extends:\n  - a.yml\n  - b.yml\n",
+				"a.yml":     "extends:\n  - base.yml\n",
+				"b.yml":     "extends:\n  - base.yml\n",
+				"base.yml":  "checkers:\n  local_return:\n",
+			},
+			checkers: map[string]map[string]interface{}{
+				"local_return": nil,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := writeTree(t, test.files)
+			defer os.RemoveAll(dir)
+			defer chdir(t, dir)()
+
+			config, err := loadConfig("lingo.yml")
+			if test.err != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, test.err, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			checkers := map[string]map[string]interface{}{}
+			for slug, checkerConfig := range config.Checkers {
+				checkers[slug] = checkerConfig.Options
+			}
+			assert.Equal(t, test.checkers, checkers)
+
+			var matchers []string
+			for _, matcher := range config.Matchers {
+				matchers = append(matchers, matcher.Type)
+			}
+			assert.Equal(t, test.matchers, matchers)
+			assert.Equal(t, test.requireReason, config.Suppression.RequireReason)
+		})
+	}
+}
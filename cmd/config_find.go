@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// configFilenames are the names of the config files which are looked up in
+// a directory in order of precedence.
+var configFilenames = []string{
+	defaultConfigFilename,
+	".lingo.yml",
+	"lingo.json",
+	"lingo.toml",
+}
+
+// configConverters contains the functions which convert the content of
+// config files in other formats to YAML mapped by the extensions of the
+// files. The converted content is parsed as a YAML config file, so all
+// formats have the same fields and semantics.
+var configConverters = map[string]func(data []byte) ([]byte, error){
+	".json": jsonToYAML,
+	".toml": tomlToYAML,
+}
+
+func jsonToYAML(data []byte) ([]byte, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(document)
+}
+
+func tomlToYAML(data []byte) ([]byte, error) {
+	var document map[string]interface{}
+	if _, err := toml.Decode(string(data), &document); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(document)
+}
+
+// resolveConfigFile sets the config file to the one found by findConfig
+// from `target` unless it is set with the --config flag of `cmd`.
+func resolveConfigFile(cmd *cobra.Command, target string) {
+	if !cmd.Flags().Changed("config") {
+		if path, ok := findConfig(target); ok {
+			configFile = path
+		}
+	}
+
+	logVerbose("config file: %s", configFile)
+}
+
+// findConfig returns the path of the config file in the directory of
+// `target` or in the nearest of its parent directories.
+func findConfig(target string) (string, bool) {
+	dir, err := filepath.Abs(strings.TrimSuffix(target, "/..."))
+	if err != nil {
+		return "", false
+	}
+
+	if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+		dir = filepath.Dir(dir)
+	}
+
+	for {
+		if path, ok := findConfigIn(dir); ok {
+			return workingPath(path), true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// findConfigIn returns the path of the config file in `dir`.
+func findConfigIn(dir string) (string, bool) {
+	for _, filename := range configFilenames {
+		path := filepath.Join(dir, filename)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+	}
+
+	return "", false
+}
+
+// workingPath returns `path` relative to the working directory if it is
+// inside the working directory.
+func workingPath(path string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+
+	return rel
+}
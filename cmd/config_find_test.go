@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindConfig(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		target      string
+		expected    string
+	}{
+		{
+			description: "config file in the target directory",
+			files: map[string]string{
+				"lingo.yml":     "",
+				"foo/lingo.yml": "",
+			},
+			target:   "foo",
+			expected: "foo/lingo.yml",
+		},
+		{
+			description: "config file in a parent directory",
+			files: map[string]string{
+				"lingo.yml":      "",
+				"foo/bar/baz.go": "",
+			},
+			target:   "foo/bar",
+			expected: "lingo.yml",
+		},
+		{
+			description: "recursive target",
+			files: map[string]string{
+				"foo/lingo.toml": "",
+				"foo/bar/baz.go": "",
+			},
+			target:   "foo/bar/...",
+			expected: "foo/lingo.toml",
+		},
+		{
+			description: "file target",
+			files: map[string]string{
+				"foo/.lingo.yml": "",
+				"foo/bar.go":     "",
+			},
+			target:   "foo/bar.go",
+			expected: "foo/.lingo.yml",
+		},
+		{
+			description: "lingo.yml before .lingo.yml",
+			files: map[string]string{
+				"lingo.yml":  "",
+				".lingo.yml": "",
+				"lingo.json": "",
+				"lingo.toml": "",
+			},
+			target:   ".",
+			expected: "lingo.yml",
+		},
+		{
+			description: ".lingo.yml before lingo.json",
+			files: map[string]string{
+				".lingo.yml": "",
+				"lingo.json": "",
+				"lingo.toml": "",
+			},
+			target:   ".",
+			expected: ".lingo.yml",
+		},
+		{
+			description: "lingo.json before lingo.toml",
+			files: map[string]string{
+				"lingo.json": "",
+				"lingo.toml": "",
+			},
+			target:   ".",
+			expected: "lingo.json",
+		},
+		{
+			description: "nearest config file before precedence",
+			files: map[string]string{
+				"lingo.yml":      "",
+				"foo/lingo.toml": "",
+			},
+			target:   "foo",
+			expected: "foo/lingo.toml",
+		},
+		{
+			description: "directory named like a config file",
+			files: map[string]string{
+				"lingo.yml/foo.go": "",
+				"lingo.json":       "",
+			},
+			target:   ".",
+			expected: "lingo.json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := writeTree(t, test.files)
+			defer os.RemoveAll(dir)
+			defer chdir(t, dir)()
+
+			path, ok := findConfig(test.target)
+			assert.True(t, ok)
+			assert.Equal(t, filepath.FromSlash(test.expected), path)
+		})
+	}
+}
+
+func TestFindConfigOutsideWorkingDirectory(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml":        "",
+		"project/main.go":  "",
+		"other/lingo.toml": "",
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, filepath.Join(dir, "project"))()
+
+	path, ok := findConfig(".")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(dir, "lingo.yml"), path)
+
+	path, ok = findConfig(filepath.Join("..", "other"))
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(dir, "other", "lingo.toml"), path)
+}
+
+func TestLoadConfigFormats(t *testing.T) {
+	tests := []struct {
+		description string
+		filename    string
+		content     string
+		checkers    map[string]map[string]interface{}
+		err         string
+		validateErr string
+	}{
+		{
+			description: "json",
+			filename:    "lingo.json",
+			content: `{
+				"matchers": [{"type": "glob", "config": {"pattern": "**/*.go"}}],
+				"checkers": {
+					"line_length": {"max_length": 100},
+					"local_return": null
+				}
+			}`,
+			checkers: map[string]map[string]interface{}{
+				"line_length":  {"max_length": 100},
+				"local_return": nil,
+			},
+		},
+		{
+			description: "toml",
+			filename:    "lingo.toml",
+			content: "[[matchers]]\ntype = \"glob\"\n\n" +
+				"[matchers.config]\npattern = \"**/*.go\"\n\n" +
+				"[checkers.line_length]\nmax_length = 100\n\n" +
+				"[checkers.local_return]\n",
+			checkers: map[string]map[string]interface{}{
+				"line_length":  {"max_length": 100},
+				"local_return": {},
+			},
+		},
+		{
+			description: "unknown field in json",
+			filename:    "lingo.json",
+			content:     `{"checker": {"local_return": null}}`,
+			err:         "failed to parse config file: lingo.json: unknown field: checker",
+		},
+		{
+			description: "unknown field in toml",
+			filename:    "lingo.toml",
+			content:     "[checker.local_return]\n",
+			err:         "failed to parse config file: lingo.toml: unknown field: checker",
+		},
+		{
+			description: "unknown option in json",
+			filename:    "lingo.json",
+			content:     `{"checkers": {"line_length": {"max_lenght": 100}}}`,
+			validateErr: "lingo.json: invalid config of checker line_length: " +
+				"max_lenght: unknown option",
+		},
+		{
+			description: "invalid value in toml",
+			filename:    "lingo.toml",
+			content:     "[checkers.func_cyclo]\nmax = 1.5\n",
+			validateErr: "lingo.toml: invalid config of checker func_cyclo: " +
+				"max: invalid value 1.5, expected int",
+		},
+		{
+			description: "syntax error in json",
+			filename:    "lingo.json",
+			content:     `{"checkers": `,
+			err:         "failed to parse config file: lingo.json: unexpected end of JSON input",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := writeTree(t, map[string]string{
+				test.filename: test.content,
+			})
+			defer os.RemoveAll(dir)
+			defer chdir(t, dir)()
+
+			config, err := loadConfig(test.filename)
+			if test.err != "" {
+				if assert.Error(t, err) {
+					assert.Equal(t, test.err, err.Error())
+				}
+				return
+			}
+			assert.NoError(t, err)
+
+			errs := config.validate()
+			if test.validateErr != "" {
+				if assert.Len(t, errs, 1) {
+					assert.Equal(t, test.validateErr, errs[0].Error())
+				}
+				return
+			}
+			assert.Empty(t, errs)
+
+			checkers := map[string]map[string]interface{}{}
+			for slug, checkerConfig := range config.Checkers {
+				checkers[slug] = checkerConfig.Options
+			}
+			assert.Equal(t, test.checkers, checkers)
+		})
+	}
+}
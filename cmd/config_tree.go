@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/s2gatev/lingo/checker"
+	"github.com/s2gatev/lingo/file"
+)
+
+// configTree resolves the configs of the checked files. With discovery
+// enabled a file is checked with the config file in the nearest directory
+// between the file and the working directory. The root config applies to the
+// files without such a config file.
+type configTree struct {
+	mutex sync.Mutex
+
+	// root is the config set with the --config flag or found from the
+	// checked path.
+	root *Config
+
+	// dir is the working directory or empty if discovery is disabled.
+	dir string
+
+	// configs contains the resolved configs mapped by directory.
+	configs map[string]*Config
+
+	// matchers contains the matchers of every loaded config.
+	matchers map[*Config][]file.Matcher
+
+	// err is the first error which occurred while matching files.
+	err error
+}
+
+func newConfigTree(root *Config, discover bool) (*configTree, error) {
+	t := &configTree{
+		root:     root,
+		configs:  map[string]*Config{},
+		matchers: map[*Config][]file.Matcher{},
+	}
+	if err := t.addMatchers(root); err != nil {
+		return nil, err
+	}
+
+	if discover {
+		dir, err := os.Getwd()
+		if err != nil {
+			return nil, err
+		}
+
+		t.dir = dir
+	}
+
+	return t, nil
+}
+
+// Matches implements the file.Matcher interface. A file matches if it is
+// accepted by all matchers of its config.
+func (t *configTree) Matches(path string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	config, err := t.resolve(filepath.Dir(path))
+	if err != nil {
+		if t.err == nil {
+			t.err = err
+		}
+		return false
+	}
+
+	for _, matcher := range t.matchers[config] {
+		if !matcher.Matches(path) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// configFor returns the config of the files in the absolute directory `dir`.
+func (t *configTree) configFor(dir string) (*Config, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return t.resolve(dir)
+}
+
+func (t *configTree) resolve(dir string) (*Config, error) {
+	if config, ok := t.configs[dir]; ok {
+		return config, nil
+	}
+
+	if !t.contains(dir) || t.excludes(dir) {
+		return t.root, nil
+	}
+
+	config, err := t.load(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if config == nil {
+		config, err = t.resolve(filepath.Dir(dir))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.configs[dir] = config
+	return config, nil
+}
+
+// contains reports whether `dir` is a subdirectory of the working directory.
+func (t *configTree) contains(dir string) bool {
+	if t.dir == "" {
+		return false
+	}
+
+	rel, err := filepath.Rel(t.dir, dir)
+	if err != nil {
+		return false
+	}
+
+	return rel != "." && rel != ".." &&
+		!strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// excludes reports whether the matchers of the root config reject the files
+// in `dir`, e.g. in a vendor directory. Config files in such directories are
+// not loaded. A Go file with an arbitrary name stands for the files in `dir`.
+func (t *configTree) excludes(dir string) bool {
+	path := filepath.Join(dir, "lingo.go")
+	for _, matcher := range t.matchers[t.root] {
+		if !matcher.Matches(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// load loads the config file in `dir` or returns nil if there is none. The
+// root config is not loaded again if it is in `dir`. A config without
+// matchers inherits the matchers of the config of the parent directory.
+func (t *configTree) load(dir string) (*Config, error) {
+	path, ok := findConfigIn(dir)
+	if !ok {
+		return nil, nil
+	}
+
+	if rootPath, err := filepath.Abs(t.root.path); err == nil && rootPath == path {
+		return t.root, nil
+	}
+
+	// Config files are referred to relative to the working directory as
+	// they are in the output of lingo check.
+	if rel, err := filepath.Rel(t.dir, path); err == nil {
+		path = rel
+	}
+
+	config, err := loadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.Matchers) == 0 {
+		parent, err := t.resolve(filepath.Dir(dir))
+		if err != nil {
+			return nil, err
+		}
+
+		t.matchers[config] = t.matchers[parent]
+		return config, nil
+	}
+
+	if err := t.addMatchers(config); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+
+	return config, nil
+}
+
+// walk loads the config files in `dir` and in all of its subdirectories
+// as they are loaded for the files checked in them. The errors of the config
+// files which failed to load are returned.
+func (t *configTree) walk(dir string) []error {
+	var errs []error
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.IsDir() {
+			return nil
+		}
+
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil
+		}
+
+		if t.contains(absPath) && t.excludes(absPath) {
+			return filepath.SkipDir
+		}
+
+		if _, err := t.configFor(absPath); err != nil {
+			errs = append(errs, err)
+			return filepath.SkipDir
+		}
+
+		return nil
+	})
+
+	return errs
+}
+
+func (t *configTree) addMatchers(config *Config) error {
+	matchers, err := config.newMatchers()
+	if err != nil {
+		return err
+	}
+
+	t.matchers[config] = matchers
+	return nil
+}
+
+// all returns all loaded configs starting with the root config.
+func (t *configTree) all() []*Config {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var nested []*Config
+	for config := range t.matchers {
+		if config != t.root {
+			nested = append(nested, config)
+		}
+	}
+	sort.Slice(nested, func(i, j int) bool {
+		return nested[i].path < nested[j].path
+	})
+
+	return append([]*Config{t.root}, nested...)
+}
+
+// newCheckers creates new instances of the checkers in all loaded configs
+// mapped by their slugs.
+func (t *configTree) newCheckers() (map[string]checker.NodeChecker, error) {
+	checkers := map[string]checker.NodeChecker{}
+	for _, config := range t.all() {
+		configCheckers, err := newCheckers(config)
+		if err != nil {
+			return nil, err
+		}
+
+		for slug, c := range configCheckers {
+			checkers[slug] = c
+		}
+	}
+
+	return checkers, nil
+}
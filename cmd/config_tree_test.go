@@ -0,0 +1,234 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// configTreeFiles is a project with nested config files and a config file
+// outside of it.
+var configTreeFiles = map[string]string{
+	"outside/lingo.yml":                 "checkers:\n  func_cyclo:\n",
+	"project/lingo.yml":                 "checkers:\n  local_return:\n",
+	"project/plain/main.go":             "package plain\n",
+	"project/nested/lingo.yml":          "checkers:\n  line_length:\n",
+	"project/nested/deeper/main.go":     "package deeper\n",
+	"project/nested/sibling/.lingo.yml": "checkers:\n  redundant_else:\n",
+	"project/sibling/lingo.json":        `{"checkers": {"func_params_count": {"max": 4}}}`,
+	"project/generated/lingo.yml": `
+matchers:
+  -
+    type: 'not'
+    config:
+      type: 'glob'
+      config:
+        pattern: '**/gen_*.go'
+checkers:
+  local_return:
+`,
+}
+
+func TestConfigTreeConfigFor(t *testing.T) {
+	dir := writeTree(t, configTreeFiles)
+	defer os.RemoveAll(dir)
+	defer chdir(t, filepath.Join(dir, "project"))()
+
+	root, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	configs, err := newConfigTree(root, true)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		description string
+		dir         string
+		expected    string
+	}{
+		{
+			description: "working directory",
+			dir:         "project",
+			expected:    "lingo.yml",
+		},
+		{
+			description: "directory without config file",
+			dir:         "project/plain",
+			expected:    "lingo.yml",
+		},
+		{
+			description: "nested config file",
+			dir:         "project/nested",
+			expected:    "nested/lingo.yml",
+		},
+		{
+			description: "directory nested in a directory with config file",
+			dir:         "project/nested/deeper",
+			expected:    "nested/lingo.yml",
+		},
+		{
+			description: "config files in nested directories",
+			dir:         "project/nested/sibling",
+			expected:    "nested/sibling/.lingo.yml",
+		},
+		{
+			description: "sibling directories",
+			dir:         "project/sibling",
+			expected:    "sibling/lingo.json",
+		},
+		{
+			description: "directory outside of the working directory",
+			dir:         "outside",
+			expected:    "lingo.yml",
+		},
+		{
+			description: "parent of the working directory",
+			dir:         "",
+			expected:    "lingo.yml",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			config, err := configs.configFor(
+				filepath.Join(dir, filepath.FromSlash(test.dir)))
+			assert.NoError(t, err)
+			assert.Equal(t, filepath.FromSlash(test.expected), config.path)
+		})
+	}
+}
+
+func TestConfigTreeWithoutDiscovery(t *testing.T) {
+	dir := writeTree(t, configTreeFiles)
+	defer os.RemoveAll(dir)
+	defer chdir(t, filepath.Join(dir, "project"))()
+
+	root, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	configs, err := newConfigTree(root, false)
+	assert.NoError(t, err)
+
+	config, err := configs.configFor(filepath.Join(dir, "project", "nested"))
+	assert.NoError(t, err)
+	assert.Equal(t, root, config)
+	assert.Equal(t, []*Config{root}, configs.all())
+}
+
+func TestConfigTreeMatches(t *testing.T) {
+	dir := writeTree(t, configTreeFiles)
+	defer os.RemoveAll(dir)
+	defer chdir(t, filepath.Join(dir, "project"))()
+
+	root, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	configs, err := newConfigTree(root, true)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		description string
+		path        string
+		expected    bool
+	}{
+		{
+			description: "file matching the nested matchers",
+			path:        "project/generated/main.go",
+			expected:    true,
+		},
+		{
+			description: "file excluded by the nested matchers",
+			path:        "project/generated/gen_main.go",
+			expected:    false,
+		},
+		{
+			description: "file in a directory without matchers",
+			path:        "project/plain/gen_main.go",
+			expected:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			path := filepath.Join(dir, filepath.FromSlash(test.path))
+			assert.Equal(t, test.expected, configs.Matches(path))
+		})
+	}
+	assert.NoError(t, configs.err)
+}
+
+func TestConfigTreeWalk(t *testing.T) {
+	files := map[string]string{
+		"lingo.yml":         "checkers:\n  local_return:\n",
+		"broken/lingo.yml":  "checkers: [\n",
+		"matcher/lingo.yml": "matchers:\n  -\n    type: 'foo'\n",
+		"valid/lingo.yml":   "checkers:\n  line_length:\n",
+	}
+	dir := writeTree(t, files)
+	defer os.RemoveAll(dir)
+	defer chdir(t, dir)()
+
+	root, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	configs, err := newConfigTree(root, true)
+	assert.NoError(t, err)
+
+	errs := configs.walk(".")
+	if assert.Len(t, errs, 2) {
+		assert.Contains(t, errs[0].Error(), filepath.Join("broken", "lingo.yml"))
+		assert.Equal(t,
+			filepath.Join("matcher", "lingo.yml")+": unknown matcher: foo",
+			errs[1].Error())
+	}
+
+	var paths []string
+	for _, config := range configs.all() {
+		paths = append(paths, config.path)
+	}
+	assert.Equal(t, []string{"lingo.yml", filepath.Join("valid", "lingo.yml")}, paths)
+}
+
+func TestConfigTreeRootMatchers(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"lingo.yml": `
+matchers:
+  -
+    type: 'not'
+    config:
+      type: 'glob'
+      config:
+        pattern: '**/vendor/**/*'
+  -
+    type: 'not'
+    config:
+      type: 'glob'
+      config:
+        pattern: '**/*_test.go'
+checkers:
+  local_return:
+`,
+		"nested/lingo.yml":     "checkers:\n  line_length:\n",
+		"vendor/foo/lingo.yml": "checkers: [\n",
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, dir)()
+
+	root, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+
+	configs, err := newConfigTree(root, true)
+	assert.NoError(t, err)
+
+	assert.True(t, configs.Matches(filepath.Join(dir, "nested", "foo.go")))
+	assert.False(t, configs.Matches(filepath.Join(dir, "nested", "foo_test.go")))
+	assert.False(t, configs.Matches(filepath.Join(dir, "vendor", "foo", "foo.go")))
+	assert.NoError(t, configs.err)
+
+	config, err := configs.configFor(filepath.Join(dir, "vendor", "foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, root, config)
+
+	assert.Empty(t, configs.walk("."))
+}
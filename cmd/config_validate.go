@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/s2gatev/lingo/cli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	ConfigValidate.PersistentFlags().StringVar(
+		&configFile, "config", defaultConfigFilename, "config file")
+
+	ConfigCommand.AddCommand(ConfigValidate)
+	Root.AddCommand(ConfigCommand)
+}
+
+// ConfigCommand is a command handler that groups the commands which work
+// with the config file.
+var ConfigCommand = &cobra.Command{
+	Use:   "config",
+	Short: "Work with the lingo config file",
+}
+
+// ConfigValidate is a command handler that checks the config file and the
+// config files in the subdirectories of the working directory for unknown
+// fields, checkers and options and for invalid values.
+var ConfigValidate = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate the config files",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		resolveConfigFile(cmd, ".")
+
+		config, err := loadConfig(configFile)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		discover := !cmd.Flags().Changed("config")
+		configs, errs := validateConfigs(config, ".", discover)
+		for _, err := range errs {
+			fmt.Println(err)
+		}
+
+		if len(errs) > 0 {
+			fmt.Println()
+			cli.ExitError("%d errors found in %d config files", len(errs), configs)
+		}
+
+		if configs == 1 {
+			cli.ExitOK("%s is valid", configFile)
+		}
+		cli.ExitOK("%d config files are valid", configs)
+	},
+}
+
+// validateConfigs validates the root `config` and, if `discover` is set, the
+// config files in `dir` and its subdirectories which are used by lingo
+// check. It returns the number of validated config files and all errors in
+// them.
+func validateConfigs(config *Config, dir string, discover bool) (int, []error) {
+	errs := config.validate()
+
+	configs, err := newConfigTree(config, discover)
+	if err != nil {
+		// The matchers of the root config are invalid and already reported.
+		return 1, errs
+	}
+
+	loadErrs := configs.walk(dir)
+	errs = append(errs, loadErrs...)
+
+	nested := configs.all()[1:]
+	for _, nestedConfig := range nested {
+		errs = append(errs, nestedConfig.validate()...)
+	}
+
+	return len(nested) + len(loadErrs) + 1, errs
+}
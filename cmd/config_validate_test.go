@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConfigs(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		configs     int
+		expected    []string
+	}{
+		{
+			description: "valid config file",
+			files: map[string]string{
+				"lingo.yml": "checkers:\n  local_return:\n",
+			},
+			configs: 1,
+		},
+		{
+			description: "valid nested config files",
+			files: map[string]string{
+				"lingo.yml":         "checkers:\n  local_return:\n",
+				"foo/lingo.yml":     "checkers:\n  line_length:\n",
+				"foo/bar/lingo.yml": "checkers:\n  func_cyclo:\n",
+			},
+			configs: 3,
+		},
+		{
+			description: "invalid nested config files",
+			files: map[string]string{
+				"lingo.yml":      "checkers:\n  line_length:\n    max_length: -1\n",
+				"foo/lingo.yml":  "checkers:\n  foo:\n",
+				"bar/.lingo.yml": "checkers:\n  func_cyclo:\n    max: 1.5\n",
+				"baz/lingo.yml":  "checkers: [\n",
+			},
+			configs: 4,
+			expected: []string{
+				"lingo.yml:3: invalid config of checker line_length: " +
+					"max_length: must not be negative",
+				"failed to parse config file: " + filepath.Join("baz", "lingo.yml") +
+					":1: did not find expected node content",
+				filepath.Join("bar", ".lingo.yml") + ":3: invalid config of " +
+					"checker func_cyclo: max: invalid value 1.5, expected int",
+				filepath.Join("foo", "lingo.yml") + ":2: unknown checker: foo",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := writeTree(t, test.files)
+			defer os.RemoveAll(dir)
+			defer chdir(t, dir)()
+
+			config, err := loadConfig("lingo.yml")
+			assert.NoError(t, err)
+
+			configs, errs := validateConfigs(config, ".", true)
+			assert.Equal(t, test.configs, configs)
+
+			var messages []string
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+			assert.Equal(t, test.expected, messages)
+		})
+	}
+}
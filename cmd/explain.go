@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/alecthomas/chroma/quick"
+	"github.com/s2gatev/lingo/checker"
+	"github.com/s2gatev/lingo/cli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	Explain.PersistentFlags().StringVar(
+		&configFile, "config", defaultConfigFilename,
+		"config file with the options of the checkers, if it exists")
+	Explain.PersistentFlags().BoolVar(
+		&explainNoColor, "no-color", false, "disable colors and syntax highlighting")
+
+	Root.AddCommand(Explain)
+}
+
+// Explain is a command handler that prints the description and the examples
+// of a checker with the options configured for the project.
+var Explain = &cobra.Command{
+	Use:   "explain <checker>",
+	Short: "Explain the rule of a checker",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		slug := args[0]
+		if !isRegistered(slug) {
+			cli.ExitError("unknown checker: %s", slug)
+		}
+
+		config, err := loadCheckersConfig(cmd)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		c, err := config.newChecker(slug)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		// Without a config file there is no project config to refer to.
+		_, enabled := config.Checkers[slug]
+		e := &explainer{
+			color:   !explainNoColor && isTerminal(os.Stdout),
+			enabled: enabled || config.path == "",
+		}
+
+		if err := e.explain(os.Stdout, slug, c); err != nil {
+			cli.ExitError("failed to explain checker: %s", err)
+		}
+	},
+}
+
+// explainer writes the explanation of a checker for a terminal.
+type explainer struct {
+	color   bool
+	enabled bool
+}
+
+// explain writes the title, the description, the options and the examples
+// of the checker `c` referenced by `slug` to `w`. The options are written
+// with the values `c` checks with.
+func (e *explainer) explain(w io.Writer, slug string, c checker.NodeChecker) error {
+	fmt.Fprintf(w, "%s (%s)\n\n", e.paint(c.Title(), ansiBold), slug)
+	fmt.Fprintf(w, "%s\n", c.Description())
+	if !e.enabled {
+		fmt.Fprintf(w, "\nThe checker is not enabled in %s.\n", configFile)
+	}
+
+	if options := checker.Options(slug); len(options) > 0 {
+		fmt.Fprintf(w, "\n%s\n\n", e.paint("Options:", ansiBold))
+
+		values := checker.OptionValues(c)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, option := range options {
+			fmt.Fprintf(tw, "    %s (%s): %v\t%s\n",
+				option.Name, option.Type, values[option.Name], option.Description)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	for _, example := range c.Examples() {
+		good, err := e.highlight(example.Good)
+		if err != nil {
+			return err
+		}
+
+		bad, err := e.highlight(example.Bad)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "\n%s\n\n%s\n", e.paint("Good:", ansiGreen), good)
+		fmt.Fprintf(w, "\n%s\n\n%s\n", e.paint("Bad:", ansiRed), bad)
+	}
+
+	return nil
+}
+
+// highlight highlights the Go `code` of an example and indents it.
+func (e *explainer) highlight(code string) (string, error) {
+	code = checker.Dedent(code)
+	if e.color {
+		var buf bytes.Buffer
+		err := quick.Highlight(&buf, code, "go", "terminal256", "pygments")
+		if err != nil {
+			return "", err
+		}
+
+		code = strings.TrimRight(buf.String(), "\n")
+	}
+
+	lines := strings.Split(code, "\n")
+	for i, line := range lines {
+		lines[i] = "    " + line
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// paint formats `text` with the ANSI escape `code` if colors are enabled.
+func (e *explainer) paint(text, code string) string {
+	if !e.color {
+		return text
+	}
+
+	return code + text + ansiReset
+}
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiRed   = "\x1b[1;31m"
+	ansiGreen = "\x1b[1;32m"
+)
+
+// isRegistered reports whether a checker is registered with `slug`.
+func isRegistered(slug string) bool {
+	for _, registered := range checker.Slugs() {
+		if registered == slug {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isTerminal reports whether `f` is a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+var explainNoColor bool
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/s2gatev/lingo/checker"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainerExplain(t *testing.T) {
+	tests := []struct {
+		description string
+		slug        string
+		config      map[string]interface{}
+		explainer   *explainer
+		expected    string
+	}{
+		{
+			description: "configured options",
+			slug:        "line_length",
+			config:      map[string]interface{}{"max_length": 100},
+			explainer: &explainer{
+				enabled: true,
+			},
+			expected: `Line Length (line_length)
+
+The maximum line of a length is 100 symbols.
+
+Options:
+
+    max_length (int): 100  the maximum length of a line
+    tab_width (int): 4     the length of a tab, 4 by default
+`,
+		},
+		{
+			description: "disabled checker",
+			slug:        "func_cyclo",
+			explainer:   &explainer{},
+			expected: `Func Cyclo Complexity (func_cyclo)
+
+The maximum cyclomatic complexity of a func is 0.
+
+The checker is not enabled in lingo.yml.
+
+Options:
+
+    max (int): 0  the maximum cyclomatic complexity of a func
+`,
+		},
+		{
+			description: "examples",
+			slug:        "redundant_else",
+			explainer: &explainer{
+				enabled: true,
+			},
+			expected: `Redundant Else (redundant_else)
+
+When an if statement ends with a terminating statement it should not be ` +
+				`followed by an else statement.
+
+Good:
+
+    if err != nil {
+    	return err
+    }
+    call(foo)
+
+Bad:
+
+    if err != nil {
+    	return err
+    } else {
+    	call(foo)
+    }
+`,
+		},
+	}
+
+	defaultConfigFile := configFile
+	defer func() {
+		configFile = defaultConfigFile
+	}()
+	configFile = defaultConfigFilename
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			c, err := checker.Get(test.slug, test.config)
+			assert.NoError(t, err)
+
+			var output bytes.Buffer
+			assert.NoError(t, test.explainer.explain(&output, test.slug, c))
+			assert.Equal(t, test.expected, output.String())
+		})
+	}
+}
+
+func TestExplainerColor(t *testing.T) {
+	c, err := checker.Get("redundant_else", nil)
+	assert.NoError(t, err)
+
+	var output bytes.Buffer
+	e := &explainer{
+		color:   true,
+		enabled: true,
+	}
+	assert.NoError(t, e.explain(&output, "redundant_else", c))
+	assert.Contains(t, output.String(), ansiBold+"Redundant Else"+ansiReset)
+	assert.Contains(t, output.String(), ansiGreen+"Good:"+ansiReset)
+	assert.Contains(t, output.String(), ansiRed+"Bad:"+ansiReset)
+	assert.Contains(t, output.String(), "\x1b[38;5;")
+}
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		description string
+		config      string
+		args        []string
+		code        int
+		expected    string
+	}{
+		{
+			description: "configured checker",
+			config:      "checkers:\n  func_params_count:\n    max: 5\n",
+			args:        []string{"explain", "func_params_count"},
+			code:        0,
+			expected:    "The maximum number of parameters of a func is 5.\n",
+		},
+		{
+			description: "default option",
+			config:      "checkers:\n  line_length:\n    max_length: 100\n",
+			args:        []string{"explain", "line_length"},
+			code:        0,
+			expected:    "tab_width (int): 4",
+		},
+		{
+			description: "checker not enabled in the config file",
+			config:      "checkers:\n  local_return:\n",
+			args:        []string{"explain", "func_params_count"},
+			code:        0,
+			expected:    "The checker is not enabled in lingo.yml.\n",
+		},
+		{
+			description: "no config file",
+			args:        []string{"explain", "func_params_count"},
+			code:        0,
+			expected:    "The maximum number of parameters of a func is 0.\n",
+		},
+		{
+			description: "unknown checker",
+			config:      "checkers:\n  local_return:\n",
+			args:        []string{"explain", "foo_bar"},
+			code:        1,
+			expected:    "lingo: unknown checker: foo_bar\n",
+		},
+		{
+			description: "invalid config file",
+			config:      "checkers:\n  func_params_count:\n    max: ten\n",
+			args:        []string{"explain", "func_params_count"},
+			code:        1,
+			expected: "lingo: lingo.yml:3: invalid config of checker " +
+				"func_params_count: max: invalid value ten, expected int\n",
+		},
+		{
+			description: "syntax error in config file",
+			config:      "checkers: [\n",
+			args:        []string{"explain", "func_params_count"},
+			code:        1,
+			expected: "lingo: failed to parse config file: lingo.yml:1: " +
+				"did not find expected node content\n",
+		},
+		{
+			description: "missing config file set with --config",
+			args:        []string{"explain", "--config", "foo.yml", "local_return"},
+			code:        1,
+			expected:    "lingo: failed to read config file: foo.yml\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			files := map[string]string{}
+			if test.config != "" {
+				files["lingo.yml"] = test.config
+			}
+			dir := writeTree(t, files)
+			defer os.RemoveAll(dir)
+
+			output, code := runLingo(t, dir, test.args...)
+			assert.Equal(t, test.code, code)
+			assert.Contains(t, output, test.expected)
+			if test.code == 0 {
+				assert.NotContains(t, output, "lingo:")
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"runtime"
+
+	"github.com/s2gatev/lingo/cli"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	Fix.PersistentFlags().StringVar(
+		&configFile, "config", defaultConfigFilename, "config file")
+	Fix.PersistentFlags().IntVar(
+		&jobs, "jobs", runtime.NumCPU(), "number of files checked in parallel")
+	Fix.PersistentFlags().BoolVar(
+		&fixDiff, "diff", false, "print the fixes as a diff instead of applying them")
+
+	Root.AddCommand(Fix)
+}
+
+// Fix is a command handler that fixes the lingo violations in a directory
+// which have a fix.
+var Fix = &cobra.Command{
+	Use:   "fix <path>",
+	Short: "Fix the lingo of all files in a directory",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		resolveConfigFile(cmd, args[0])
+
+		config, err := loadConfig(configFile)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		run := newCheckRun()
+		if !cmd.Flags().Changed("config") {
+			run.discoverConfigs()
+		}
+		reports, err := run.checkDir(config, args[0])
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		fixedErrors, err := run.fix(reports, !fixDiff)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		// The diff is the only output so that it can be piped to patch.
+		if fixDiff {
+			return
+		}
+
+		remainingErrors := 0
+		for _, report := range reports {
+			remainingErrors += len(report.Errors)
+		}
+
+		cli.ExitOK("%d violations fixed, %d violations remaining",
+			fixedErrors, remainingErrors)
+	},
+}
+
+var fixDiff bool
@@ -2,113 +2,232 @@ package cmd
 
 import (
 	"bytes"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 
 	"github.com/alecthomas/chroma/quick"
 	"github.com/alecthomas/template"
 	"github.com/s2gatev/lingo/checker"
 	"github.com/s2gatev/lingo/cli"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v2"
 )
 
 func init() {
 	Guide.PersistentFlags().StringVar(
 		&configFile, "config", defaultConfigFilename, "config file")
+	Guide.PersistentFlags().StringVar(
+		&guideOutput, "output", "", "output file instead of the standard output")
+	Guide.PersistentFlags().StringVar(
+		&guideFormat, "format", defaultGuideFormat, "output format: html, markdown")
+	Guide.PersistentFlags().BoolVar(
+		&guideOpen, "open", false, "open the guide in a browser")
+	Guide.PersistentFlags().BoolVar(
+		&guideServe, "serve", false, "serve the guide over HTTP")
+	Guide.PersistentFlags().StringVar(
+		&guideAddr, "addr", defaultGuideAddr, "address of the guide server")
 
 	Root.AddCommand(Guide)
 }
 
-// Guide is a command handler that displays a guidebook of rules applicable
+// Guide is a command handler that writes a guidebook of rules applicable
 // for the current project.
 var Guide = &cobra.Command{
 	Use:   "guide",
 	Short: "Read a guide with the lingo of the project",
 	Run: func(cmd *cobra.Command, args []string) {
-		configData, err := ioutil.ReadFile(configFile)
+		renderer, ok := guideRenderers[guideFormat]
+		if !ok {
+			cli.ExitError("unknown format: %s", guideFormat)
+		}
+
+		resolveConfigFile(cmd, ".")
+
+		if guideServe {
+			if err := serveGuide(guideAddr, renderer); err != nil {
+				cli.ExitError("%s", err)
+			}
+			return
+		}
+
+		config, err := loadConfig(configFile)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		data, err := newGuideData(config, renderer.highlight)
 		if err != nil {
-			cli.ExitError("failed to read config file: %s", configFile)
+			cli.ExitError("%s", err)
+		}
+
+		// A guide which is only opened is written to a temporary file.
+		path := guideOutput
+		if path == "" && guideOpen {
+			dir, err := ioutil.TempDir("", "lingo")
+			if err != nil {
+				cli.ExitError("failed to create guide dir")
+			}
+
+			path = filepath.Join(dir, "guide"+renderer.extension)
 		}
 
-		var config Config
-		if err := yaml.Unmarshal(configData, &config); err != nil {
-			cli.ExitError("failed to parse config file: %s", configFile)
+		if err := writeGuide(path, renderer, data); err != nil {
+			cli.ExitError("%s", err)
 		}
 
-		var checkers []checker.NodeChecker
-		for slug, config := range config.Checkers {
-			c := checker.Get(slug, config)
-			if c == nil {
-				cli.ExitError("unknown checker: %s", slug)
+		if guideOpen {
+			absPath, err := filepath.Abs(path)
+			if err != nil {
+				cli.ExitError("failed to resolve guide file: %s", path)
 			}
 
-			checkers = append(checkers, c)
+			if err := openBrowser("file://" + absPath); err != nil {
+				cli.ExitError("failed to open guide")
+			}
 		}
+	},
+}
+
+// guideRenderer renders a guide in some format.
+type guideRenderer struct {
 
-		configPath, err := filepath.Abs(configFile)
+	// template renders the guide data.
+	template *template.Template
+
+	// highlight formats the code of an example.
+	highlight func(code string) (string, error)
+
+	// extension is the extension of a guide file.
+	extension string
+
+	// contentType is the media type of a served guide.
+	contentType string
+}
+
+const defaultGuideFormat = "html"
+
+// guideRenderers contains the renderers of a guide mapped by the slugs of
+// their formats.
+var guideRenderers = map[string]*guideRenderer{
+	"html": {
+		template:    guideTemplate,
+		highlight:   highlightHTML,
+		extension:   ".html",
+		contentType: "text/html; charset=utf-8",
+	},
+	"markdown": {
+		template:    markdownGuideTemplate,
+		highlight:   dedentCode,
+		extension:   ".md",
+		contentType: "text/markdown; charset=utf-8",
+	},
+}
+
+// guideData is the data rendered by a guide template.
+type guideData struct {
+
+	// Project is the name of the project.
+	Project string
+
+	// Items contains the rules of the project sorted by checker slug.
+	Items []guideItem
+}
+
+// newGuideData creates the guide data of the checkers in `config`. The
+// code of the examples is formatted by `highlight`.
+func newGuideData(
+	config *Config,
+	highlight func(code string) (string, error)) (*guideData, error) {
+
+	configPath, err := filepath.Abs(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve config file: %s", configFile)
+	}
+
+	data := &guideData{
+		Project: filepath.Base(filepath.Dir(configPath)),
+	}
+
+	var slugs []string
+	for slug := range config.Checkers {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	for _, slug := range slugs {
+		c, err := config.newChecker(slug)
 		if err != nil {
-			cli.ExitError("failed to resolve config file: %s", configFile)
+			return nil, err
 		}
 
-		project := filepath.Base(filepath.Dir(configPath))
+		item := guideItem{
+			Slug:        slug,
+			Title:       c.Title(),
+			Description: c.Description(),
+		}
 
-		var items []guideItem
-		for _, checker := range checkers {
-			item := guideItem{
-				Title:       checker.Title(),
-				Description: checker.Description(),
+		for _, example := range c.Examples() {
+			good, err := highlight(example.Good)
+			if err != nil {
+				return nil, fmt.Errorf("failed to init example: %s", c.Title())
 			}
 
-			for _, example := range checker.Examples() {
-				var good bytes.Buffer
-				err = quick.Highlight(&good, example.Good, "go", "html", "github")
-				if err != nil {
-					cli.ExitError("failed to init example: %s", checker.Title())
-				}
-
-				var bad bytes.Buffer
-				err = quick.Highlight(&bad, example.Bad, "go", "html", "github")
-				if err != nil {
-					cli.ExitError("failed to init example: %s", checker.Title())
-				}
-
-				item.Examples = append(item.Examples, guideItemExample{
-					Good: good.String(),
-					Bad:  bad.String(),
-				})
+			bad, err := highlight(example.Bad)
+			if err != nil {
+				return nil, fmt.Errorf("failed to init example: %s", c.Title())
 			}
 
-			items = append(items, item)
+			item.Examples = append(item.Examples, guideItemExample{
+				Good: good,
+				Bad:  bad,
+			})
 		}
 
-		dir, err := ioutil.TempDir("", "lingo")
-		if err != nil {
-			cli.ExitError("failed to create guide dir")
-		}
+		data.Items = append(data.Items, item)
+	}
 
-		guide, err := os.Create(filepath.Join(dir, "guide.html"))
+	return data, nil
+}
+
+// writeGuide renders `data` with `renderer` to the file at `path` or to the
+// standard output if `path` is empty.
+func writeGuide(path string, renderer *guideRenderer, data *guideData) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		guide, err := os.Create(path)
 		if err != nil {
-			cli.ExitError("failed to create guide file")
+			return fmt.Errorf("failed to create guide file: %s", path)
 		}
 		defer guide.Close()
 
-		data := map[string]interface{}{
-			"Project": project,
-			"Items":   items,
-		}
+		w = guide
+	}
 
-		if err := guideTemplate.Execute(guide, data); err != nil {
-			cli.ExitError("failed to initialize guide")
-		}
+	if err := renderer.template.Execute(w, data); err != nil {
+		return fmt.Errorf("failed to write guide: %s", err)
+	}
 
-		if err := openBrowser("file://" + guide.Name()); err != nil {
-			cli.ExitError("failed to open guide")
-		}
-	},
+	return nil
+}
+
+// highlightHTML highlights `code` as HTML.
+func highlightHTML(code string) (string, error) {
+	var buf bytes.Buffer
+	if err := quick.Highlight(&buf, code, "go", "html", "github"); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+// dedentCode returns `code` without its common indentation.
+func dedentCode(code string) (string, error) {
+	return checker.Dedent(code), nil
 }
 
 // openBrowser tries to open the URL in a browser.
@@ -129,14 +248,17 @@ func openBrowser(url string) error {
 type guideItemExample struct {
 
 	// Good is an example of sticking to the rule.
-	Good string
+	Good string `json:"good"`
 
 	// Bad is a counter-example that shows how to not apply the rule.
-	Bad string
+	Bad string `json:"bad"`
 }
 
 type guideItem struct {
 
+	// Slug is the slug of the checker of the item.
+	Slug string
+
 	// Title is the title of the item.
 	Title string
 
@@ -149,6 +271,10 @@ type guideItem struct {
 
 var guideTemplate = template.Must(template.New("html").Parse(guideContent))
 
+var markdownGuideTemplate = template.Must(template.New("markdown").
+	Funcs(template.FuncMap{"fence": fence}).
+	Parse(markdownGuideContent))
+
 const guideContent = `
 <!DOCTYPE html>
 <html>
@@ -186,15 +312,29 @@ const guideContent = `
 				padding: 0 10px;
 				border: 1px solid #eaecef;
 			}
+
+			.item h2 a {
+				color: inherit;
+				text-decoration: none;
+			}
+
+			.search {
+				width: 100%;
+				box-sizing: border-box;
+				padding: 8px 10px;
+				font-size: 16px;
+				border: 1px solid #ddd;
+			}
 		</style>
 	</head>
 	<body>
 		<div class="page">
 			<h1>{{.Project}}'s lingo</h1>
+			<input class="search" type="search" placeholder="Search rules">
 			<div class="items">
 				{{range .Items}}
-				<div class="item">
-					<h2>{{.Title}}</h2>
+				<div class="item" id="{{.Slug}}">
+					<h2><a href="#{{.Slug}}">{{.Title}}</a></h2>
 					<p>{{.Description}}</p>
 
 					{{range .Examples}}
@@ -208,6 +348,50 @@ const guideContent = `
 				{{end}}
 			</div>
 		</div>
+		<script>
+			var search = document.querySelector(".search");
+			search.addEventListener("input", function() {
+				var query = search.value.toLowerCase();
+				document.querySelectorAll(".item").forEach(function(item) {
+					var text = item.querySelector("h2").textContent + " " +
+						item.querySelector("p").textContent;
+					var matches = text.toLowerCase().indexOf(query) >= 0;
+					item.style.display = matches ? "" : "none";
+				});
+			});
+		</script>
 	</body>
 </html>
 `
+
+const markdownGuideContent = `# {{.Project}}'s lingo
+{{range .Items}}
+## {{.Title}}
+
+{{.Description}}
+{{range .Examples}}
+Bad:
+
+{{fence .Bad}}
+
+Good:
+
+{{fence .Good}}
+{{end}}{{end}}`
+
+// fence formats Go `code` as a fenced Markdown code block.
+func fence(code string) string {
+	return "```go\n" + code + "\n```"
+}
+
+var guideOutput string
+
+var guideFormat string
+
+var guideOpen bool
+
+var guideServe bool
+
+const defaultGuideAddr = "localhost:8080"
+
+var guideAddr string
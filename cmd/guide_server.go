@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// guideHandler serves the guide of the project. The config file is read on
+// every request so that changes to it are shown when the guide is reloaded.
+type guideHandler struct {
+	renderer *guideRenderer
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *guideHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+
+	config, err := loadConfig(configFile)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := newGuideData(config, h.renderer.highlight)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// The guide is rendered before it is written so that a failure results
+	// in an error response instead of a partial guide.
+	var buf bytes.Buffer
+	if err := h.renderer.template.Execute(&buf, data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", h.renderer.contentType)
+	buf.WriteTo(w)
+}
+
+// serveGuide serves the guide rendered by `renderer` on `addr` until the
+// server fails. The guide is opened in a browser if --open is set.
+func serveGuide(addr string, renderer *guideRenderer) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %s", addr, err)
+	}
+	defer listener.Close()
+
+	url := "http://" + listener.Addr().String() + "/"
+	fmt.Printf("lingo: serving the guide on %s\n", url)
+
+	if guideOpen {
+		if err := openBrowser(url); err != nil {
+			return fmt.Errorf("failed to open guide")
+		}
+	}
+
+	return http.Serve(listener, &guideHandler{
+		renderer: renderer,
+	})
+}
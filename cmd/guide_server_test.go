@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuideHandler(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"project/lingo.yml": guideConfig,
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, filepath.Join(dir, "project"))()
+
+	defaultConfigFile := configFile
+	defer func() {
+		configFile = defaultConfigFile
+	}()
+	configFile = defaultConfigFilename
+
+	handler := &guideHandler{
+		renderer: guideRenderers["markdown"],
+	}
+	serve := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest("GET", path, nil))
+		return recorder
+	}
+
+	tests := []struct {
+		description string
+		config      string
+		path        string
+		code        int
+		contentType string
+		body        string
+	}{
+		{
+			description: "guide",
+			config:      guideConfig,
+			path:        "/",
+			code:        http.StatusOK,
+			contentType: "text/markdown; charset=utf-8",
+			body:        markdownGuide,
+		},
+		{
+			description: "changed config file",
+			config:      "checkers:\n  func_cyclo:\n    max: 20\n",
+			path:        "/",
+			code:        http.StatusOK,
+			contentType: "text/markdown; charset=utf-8",
+			body: "# project's lingo\n\n## Func Cyclo Complexity\n\n" +
+				"The maximum cyclomatic complexity of a func is 20.\n",
+		},
+		{
+			description: "unknown path",
+			config:      guideConfig,
+			path:        "/foo",
+			code:        http.StatusNotFound,
+			contentType: "text/plain; charset=utf-8",
+			body:        "404 page not found\n",
+		},
+		{
+			description: "invalid config file",
+			config:      "checkers: [\n",
+			path:        "/",
+			code:        http.StatusInternalServerError,
+			contentType: "text/plain; charset=utf-8",
+			body: "failed to parse config file: lingo.yml:1: " +
+				"did not find expected node content\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			err := ioutil.WriteFile(defaultConfigFilename, []byte(test.config), 0644)
+			assert.NoError(t, err)
+
+			response := serve(test.path)
+			assert.Equal(t, test.code, response.Code)
+			assert.Equal(t, test.contentType, response.Header().Get("Content-Type"))
+			assert.Equal(t, test.body, response.Body.String())
+		})
+	}
+}
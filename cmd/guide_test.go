@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// guideConfig enables a checker with options and a checker with examples.
+const guideConfig = `
+checkers:
+  func_cyclo:
+    max: 12
+  redundant_else:
+`
+
+// markdownGuide is the Markdown guide of guideConfig.
+const markdownGuide = "# project's lingo\n" +
+	`
+## Func Cyclo Complexity
+
+The maximum cyclomatic complexity of a func is 12.
+
+## Redundant Else
+
+When an if statement ends with a terminating statement it should not be ` +
+	`followed by an else statement.
+
+Bad:
+
+` + "```go" + `
+if err != nil {
+	return err
+} else {
+	call(foo)
+}
+` + "```" + `
+
+Good:
+
+` + "```go" + `
+if err != nil {
+	return err
+}
+call(foo)
+` + "```" + `
+`
+
+func TestWriteGuide(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected []string
+	}{
+		{
+			format:   "markdown",
+			expected: []string{markdownGuide},
+		},
+		{
+			format: "html",
+			expected: []string{
+				"<title>project's lingo</title>",
+				`<div class="item" id="func_cyclo">`,
+				`<h2><a href="#func_cyclo">Func Cyclo Complexity</a></h2>`,
+				"<p>The maximum cyclomatic complexity of a func is 12.</p>",
+				`<div class="item" id="redundant_else">`,
+				`<pre class="chroma">`,
+				`<span class="k">return</span>`,
+			},
+		},
+	}
+
+	dir := writeTree(t, map[string]string{
+		"project/lingo.yml": guideConfig,
+	})
+	defer os.RemoveAll(dir)
+	defer chdir(t, filepath.Join(dir, "project"))()
+
+	for _, test := range tests {
+		t.Run(test.format, func(t *testing.T) {
+			renderer := guideRenderers[test.format]
+			path := filepath.Join(dir, "guide"+renderer.extension)
+
+			config, err := loadConfig("lingo.yml")
+			assert.NoError(t, err)
+
+			data, err := newGuideData(config, renderer.highlight)
+			assert.NoError(t, err)
+			assert.NoError(t, writeGuide(path, renderer, data))
+
+			guide, err := ioutil.ReadFile(path)
+			assert.NoError(t, err)
+			for _, expected := range test.expected {
+				assert.Contains(t, string(guide), expected)
+			}
+		})
+	}
+}
+
+func TestGuide(t *testing.T) {
+	tests := []struct {
+		description string
+		args        []string
+		code        int
+		output      string
+		guide       string
+	}{
+		{
+			description: "standard output",
+			args:        []string{"guide", "--format", "markdown"},
+			code:        0,
+			output:      markdownGuide,
+		},
+		{
+			description: "output file",
+			args: []string{
+				"guide", "--format", "markdown", "--output", "GUIDE.md"},
+			code:  0,
+			guide: markdownGuide,
+		},
+		{
+			description: "unknown format",
+			args:        []string{"guide", "--format", "pdf"},
+			code:        1,
+			output:      "lingo: unknown format: pdf\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := writeTree(t, map[string]string{
+				"project/lingo.yml": guideConfig,
+			})
+			defer os.RemoveAll(dir)
+
+			output, code := runLingo(t, filepath.Join(dir, "project"), test.args...)
+			assert.Equal(t, test.code, code)
+			assert.Equal(t, test.output, output)
+
+			if test.guide != "" {
+				guide, err := ioutil.ReadFile(filepath.Join(dir, "project", "GUIDE.md"))
+				assert.NoError(t, err)
+				assert.Equal(t, test.guide, string(guide))
+			}
+		})
+	}
+}
+
+func TestHighlightHTML(t *testing.T) {
+	code, err := highlightHTML("x := \"<b>\"")
+	assert.NoError(t, err)
+	assert.Contains(t, code, "&#34;&lt;b&gt;&#34;")
+	assert.NotContains(t, code, "<b>")
+	assert.Contains(t, code, `<pre class="chroma">`)
+}
@@ -0,0 +1,318 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/s2gatev/lingo/checker"
+	"github.com/s2gatev/lingo/cli"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+func init() {
+	Init.PersistentFlags().StringVar(
+		&configFile, "config", defaultConfigFilename, "config file to write")
+	Init.PersistentFlags().IntVar(
+		&jobs, "jobs", runtime.NumCPU(), "number of files checked in parallel")
+	Init.PersistentFlags().BoolVar(
+		&initForce, "force", false, "overwrite an existing config file")
+
+	Root.AddCommand(Init)
+}
+
+// Init is a command handler that writes a starter config file for the code
+// in a directory. The config enables every checker which the code already
+// satisfies and infers the numeric options of the other checkers from it.
+var Init = &cobra.Command{
+	Use:   "init",
+	Short: "Create a config file from the lingo of all files in a directory",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		root := "./..."
+		if len(args) > 0 {
+			root = args[0]
+		}
+
+		if _, err := os.Stat(configFile); err == nil && !initForce {
+			cli.ExitError("config file already exists: %s", configFile)
+		}
+
+		var config Config
+		if err := yaml.Unmarshal([]byte(initMatchers), &config); err != nil {
+			cli.ExitError("failed to parse default matchers: %s", err)
+		}
+
+		// Checkers with numeric options are run with the lowest values of
+		// their options, so that their violations are counted as well.
+		config.Checkers = map[string]CheckerConfig{}
+		for _, slug := range checker.Slugs() {
+			config.Checkers[slug] = CheckerConfig{}
+			if t, ok := thresholds[slug]; ok {
+				config.Checkers[slug] = CheckerConfig{Options: t.with(t.min)}
+			}
+		}
+
+		run := newCheckRun()
+		reports, err := run.checkDir(&config, root)
+		if err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		counts := map[string]int{}
+		for _, report := range reports {
+			for _, err := range report.Errors {
+				counts[err.Slug]++
+			}
+		}
+
+		// Checkers with violations are left out of the config, checkers
+		// with numeric options are configured so that the code passes.
+		for slug, count := range counts {
+			if count > 0 {
+				delete(config.Checkers, slug)
+			}
+		}
+		for slug, t := range thresholds {
+			config.Checkers[slug] = CheckerConfig{Options: t.infer(run)}
+		}
+
+		printInitSummary(config.Checkers, counts)
+
+		if err := writeInitConfig(configFile, config.Checkers, counts); err != nil {
+			cli.ExitError("%s", err)
+		}
+
+		cli.ExitOK("%d of %d checkers enabled in %s",
+			len(config.Checkers), len(checker.Slugs()), configFile)
+	},
+}
+
+// threshold infers a numeric option of a checker from the code.
+type threshold struct {
+
+	// option is the name of the inferred option.
+	option string
+
+	// min is the lowest value of the option which is inferred even if the
+	// code would satisfy a lower one.
+	min int
+
+	// options contains the other options of the checker.
+	options map[string]interface{}
+
+	// measure returns the values in `file` which are limited by the option.
+	measure func(file *ast.File, content string) []int
+}
+
+// infer returns the options of the checker with the lowest value of the
+// option which is satisfied by all files of `run`.
+func (t *threshold) infer(run *checkRun) map[string]interface{} {
+	value := t.min
+	for path, file := range run.files {
+		for _, measured := range t.measure(file, run.contents[path]) {
+			if measured > value {
+				value = measured
+			}
+		}
+	}
+
+	return t.with(value)
+}
+
+// with returns the options of the checker with `value` as the value of the
+// option.
+func (t *threshold) with(value int) map[string]interface{} {
+	options := map[string]interface{}{
+		t.option: value,
+	}
+	for key, option := range t.options {
+		options[key] = option
+	}
+
+	return options
+}
+
+// initTabWidth is the tab width used to measure the length of lines.
+const initTabWidth = 4
+
+// thresholds contains the checkers with numeric options mapped by their
+// slugs.
+var thresholds = map[string]*threshold{
+	"line_length": {
+		option: "max_length",
+		min:    80,
+		options: map[string]interface{}{
+			"tab_width": initTabWidth,
+		},
+		measure: func(file *ast.File, content string) []int {
+			var lengths []int
+			for _, line := range strings.Split(content, "\n") {
+				lengths = append(lengths, checker.LineLength(line, initTabWidth))
+			}
+			return lengths
+		},
+	},
+	"func_cyclo": {
+		option: "max",
+		min:    10,
+		measure: func(file *ast.File, content string) []int {
+			var complexities []int
+			ast.Inspect(file, func(node ast.Node) bool {
+				if funcDecl, ok := node.(*ast.FuncDecl); ok {
+					complexities = append(complexities, checker.FuncCyclo(funcDecl))
+				}
+				return true
+			})
+			return complexities
+		},
+	},
+	"func_params_count": {
+		option: "max",
+		min:    3,
+		measure: func(file *ast.File, content string) []int {
+			return measureFuncTypes(file, func(funcType *ast.FuncType) int {
+				return checker.FieldCount(funcType.Params)
+			})
+		},
+	},
+	"func_results_count": {
+		option: "max",
+		min:    2,
+		measure: func(file *ast.File, content string) []int {
+			return measureFuncTypes(file, func(funcType *ast.FuncType) int {
+				return checker.FieldCount(funcType.Results)
+			})
+		},
+	},
+}
+
+// measureFuncTypes returns the values of `measure` for all func types in
+// `file`.
+func measureFuncTypes(file *ast.File, measure func(*ast.FuncType) int) []int {
+	var values []int
+	ast.Inspect(file, func(node ast.Node) bool {
+		if funcType, ok := node.(*ast.FuncType); ok {
+			values = append(values, measure(funcType))
+		}
+		return true
+	})
+
+	return values
+}
+
+// printInitSummary prints the number of violations of every registered
+// checker and the inferred options of the enabled checkers. The violations
+// of checkers with numeric options are counted with the lowest values of
+// their options.
+func printInitSummary(
+	checkers map[string]CheckerConfig,
+	counts map[string]int) {
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, slug := range checker.Slugs() {
+		violations := formatViolations(counts[slug])
+		status := "enabled"
+		if t, ok := thresholds[slug]; ok {
+			violations += " with " + formatOptions(t.with(t.min))
+			status = formatOptions(checkers[slug].Options)
+		} else if counts[slug] > 0 {
+			status = "disabled"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", slug, violations, status)
+	}
+	w.Flush()
+	fmt.Println()
+}
+
+// writeInitConfig writes a config file with the default matchers and
+// `checkers` to `path`. Checkers which are left out because of violations
+// are listed as comments.
+func writeInitConfig(
+	path string,
+	checkers map[string]CheckerConfig,
+	counts map[string]int) error {
+
+	var buf bytes.Buffer
+	buf.WriteString(initMatchers)
+	buf.WriteString("\ncheckers:\n")
+	for _, slug := range checker.Slugs() {
+		checkerConfig, ok := checkers[slug]
+		if !ok {
+			fmt.Fprintf(&buf, "  # %s: %s\n", slug, formatViolations(counts[slug]))
+			continue
+		}
+
+		fmt.Fprintf(&buf, "  %s:\n", slug)
+		options := checkerConfig.Options
+		for _, key := range sortedKeys(options) {
+			fmt.Fprintf(&buf, "    %s: %v\n", key, options[key])
+		}
+	}
+
+	if err := ioutil.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write config file: %s", path)
+	}
+
+	return nil
+}
+
+// formatViolations formats the number of violations `count`.
+func formatViolations(count int) string {
+	if count == 1 {
+		return "1 violation"
+	}
+
+	return fmt.Sprintf("%d violations", count)
+}
+
+// formatOptions formats `options` as a comma-separated list of key-value
+// pairs.
+func formatOptions(options map[string]interface{}) string {
+	var pairs []string
+	for _, key := range sortedKeys(options) {
+		pairs = append(pairs, fmt.Sprintf("%s: %v", key, options[key]))
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+func sortedKeys(options map[string]interface{}) []string {
+	var keys []string
+	for key := range options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// initMatchers are the matchers of a config file created by lingo init. They
+// match all .go files except those under vendor/ and tests.
+const initMatchers = `matchers:
+  -
+    type: 'glob'
+    config:
+      pattern: '**/*.go'
+  -
+    type: 'not'
+    config:
+      type: 'glob'
+      config:
+        pattern: '**/vendor/**/*'
+  -
+    type: 'not'
+    config:
+      type: 'glob'
+      config:
+        pattern: '**/*_test.go'
+`
+
+var initForce bool
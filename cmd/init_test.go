@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// initSource violates multi_word_ident_name, has an 85 characters long line
+// and a func with 4 parameters.
+const initSource = `package foo
+
+var foo_bar = 1
+
+// Foo does something with a very long line that goes beyond the default limit of 80.
+func Foo(a int, b int, c int, d int) {}
+`
+
+func TestInitConfig(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"foo.go":               initSource,
+		"foo_test.go":          "package foo\n\nvar bar_baz = 1\n",
+		"vendor/bar/bar.go":    "package bar\n\nvar bar_baz = 1\n",
+		"bar/bar.go":           "package bar\n",
+		"bar/bar_generated.go": "package bar\n",
+	})
+	defer os.RemoveAll(dir)
+
+	output, code := runLingo(t, dir, "init")
+	assert.Equal(t, 0, code)
+	assert.Contains(t, output, "lingo: 14 of 16 checkers enabled in lingo.yml")
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "lingo.yml"))
+	assert.NoError(t, err)
+
+	content := string(data)
+	assert.Contains(t, content, initMatchers)
+	for _, expected := range []string{
+		"  bad_range_reference:\n",
+		"  func_cyclo:\n    max: 10\n",
+		"  func_params_count:\n    max: 4\n",
+		"  func_results_count:\n    max: 2\n",
+		"  # group_param_types: 3 violations\n",
+		"  line_length:\n    max_length: 85\n    tab_width: 4\n",
+		"  # multi_word_ident_name: 1 violation\n",
+	} {
+		assert.Contains(t, content, expected)
+	}
+
+	defer chdir(t, dir)()
+	config, err := loadConfig("lingo.yml")
+	assert.NoError(t, err)
+	assert.Empty(t, config.validate())
+}
+
+func TestInitSummary(t *testing.T) {
+	dir := writeTree(t, map[string]string{
+		"foo.go": initSource,
+	})
+	defer os.RemoveAll(dir)
+
+	output, code := runLingo(t, dir, "init")
+	assert.Equal(t, 0, code)
+
+	for _, expected := range []string{
+		`bad_range_reference\s+0 violations\s+enabled\n`,
+		`func_cyclo\s+0 violations with max: 10\s+max: 10\n`,
+		`func_params_count\s+1 violation with max: 3\s+max: 4\n`,
+		`line_length\s+1 violation with max_length: 80, tab_width: 4\s+` +
+			`max_length: 85, tab_width: 4\n`,
+		`multi_word_ident_name\s+1 violation\s+disabled\n`,
+	} {
+		assert.Regexp(t, regexp.MustCompile(expected), output)
+	}
+}
+
+func TestInitExistingConfig(t *testing.T) {
+	existing := "checkers:\n  local_return:\n"
+
+	tests := []struct {
+		description string
+		args        []string
+		code        int
+		output      string
+		overwritten bool
+	}{
+		{
+			description: "existing config file",
+			args:        []string{"init"},
+			code:        1,
+			output:      "lingo: config file already exists: lingo.yml\n",
+		},
+		{
+			description: "overwritten config file",
+			args:        []string{"init", "--force"},
+			code:        0,
+			overwritten: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := writeTree(t, map[string]string{
+				"foo.go":    initSource,
+				"lingo.yml": existing,
+			})
+			defer os.RemoveAll(dir)
+
+			output, code := runLingo(t, dir, test.args...)
+			assert.Equal(t, test.code, code)
+			if test.output != "" {
+				assert.Equal(t, test.output, output)
+			}
+
+			data, err := ioutil.ReadFile(filepath.Join(dir, "lingo.yml"))
+			assert.NoError(t, err)
+			assert.Equal(t, test.overwritten, string(data) != existing)
+		})
+	}
+}
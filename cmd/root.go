@@ -1,9 +1,30 @@
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	Root.PersistentFlags().BoolVarP(
+		&verbose, "verbose", "v", false,
+		"print details of the run, e.g. the config file used")
+}
 
 // Root is a dummy command handler.
 var Root = &cobra.Command{
 	Use:   "lingo",
 	Short: "Lingo helps you define and enforce project-specific Go lingo",
 }
+
+// logVerbose prints a formatted message in verbose mode. Messages are printed
+// to the standard error so that they do not mix with the output of commands.
+func logVerbose(message string, args ...interface{}) {
+	if verbose {
+		fmt.Fprintf(os.Stderr, "lingo: %s\n", fmt.Sprintf(message, args...))
+	}
+}
+
+var verbose bool
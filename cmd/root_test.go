@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// lingoArgsEnv is the environment variable with the newline-separated
+// arguments of the lingo command run by the test binary instead of the tests.
+const lingoArgsEnv = "LINGO_TEST_ARGS"
+
+func TestMain(m *testing.M) {
+	if args := os.Getenv(lingoArgsEnv); args != "" {
+		Root.SetArgs(strings.Split(args, "\n"))
+		if err := Root.Execute(); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// runLingo runs lingo with `args` in `dir` in a new process, so that commands
+// which exit can be tested, and returns its output and exit code.
+func runLingo(t *testing.T, dir string, args ...string) (string, int) {
+	cmd := exec.Command(os.Args[0])
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), lingoArgsEnv+"="+strings.Join(args, "\n"))
+
+	output, err := cmd.Output()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return string(output), exitErr.ExitCode()
+	}
+	assert.NoError(t, err)
+
+	return string(output), 0
+}
+
+// writeTree creates a temporary directory with `files` mapped by their
+// slash-separated paths and returns its path.
+func writeTree(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "lingo")
+	assert.NoError(t, err)
+
+	// The working directory is reported without symlinks.
+	dir, err = filepath.EvalSymlinks(dir)
+	assert.NoError(t, err)
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+
+	return dir
+}
+
+// chdir changes the working directory to `dir` and returns a func which
+// restores the previous one.
+func chdir(t *testing.T, dir string) func() {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(dir))
+
+	return func() {
+		assert.NoError(t, os.Chdir(wd))
+	}
+}
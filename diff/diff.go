@@ -0,0 +1,137 @@
+package diff
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Diff describes the lines which were added or modified by a unified diff.
+type Diff struct {
+	lines map[string]map[int]struct{}
+}
+
+var hunkHeader = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+(\d+)(?:,(\d+))? @@`)
+
+// Parse parses the unified diff read from `r`. The paths of the files in
+// the diff are resolved relative to `root`. The "b/" prefix which git adds
+// to the paths of the new files is removed.
+func Parse(r io.Reader, root string) (*Diff, error) {
+	d := &Diff{
+		lines: map[string]map[int]struct{}{},
+	}
+
+	var path string
+	var line, oldRemaining, newRemaining int
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		text := scanner.Text()
+
+		// Lines inside of a hunk are interpreted by the counts of its
+		// header so that removed lines which look like headers are not
+		// mistaken for them.
+		if oldRemaining > 0 || newRemaining > 0 {
+			switch {
+			case strings.HasPrefix(text, "+"):
+				if path != "" {
+					d.lines[path][line] = struct{}{}
+				}
+				line++
+				newRemaining--
+			case strings.HasPrefix(text, "-"):
+				oldRemaining--
+			case strings.HasPrefix(text, `\`):
+				// "\ No newline at end of file"
+			default:
+				line++
+				oldRemaining--
+				newRemaining--
+			}
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(text, "+++ "):
+			path = d.parsePath(strings.TrimPrefix(text, "+++ "), root)
+
+		case strings.HasPrefix(text, "@@ "):
+			match := hunkHeader.FindStringSubmatch(text)
+			if match == nil {
+				return nil, fmt.Errorf("invalid hunk header: %s", text)
+			}
+
+			oldRemaining = parseCount(match[1])
+			line, _ = strconv.Atoi(match[2])
+			newRemaining = parseCount(match[3])
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// parsePath parses the path of a new file in the header of a diff and
+// registers it. An empty path is returned for deleted files.
+func (d *Diff) parsePath(header, root string) string {
+	// The path may be followed by a timestamp.
+	if index := strings.Index(header, "\t"); index >= 0 {
+		header = header[:index]
+	}
+
+	if header == "/dev/null" {
+		return ""
+	}
+
+	path := filepath.FromSlash(strings.TrimPrefix(header, "b/"))
+	path = filepath.Join(root, path)
+	if d.lines[path] == nil {
+		d.lines[path] = map[int]struct{}{}
+	}
+
+	return path
+}
+
+// parseCount parses the line count of a hunk header which defaults to 1.
+func parseCount(count string) int {
+	if count == "" {
+		return 1
+	}
+
+	n, _ := strconv.Atoi(count)
+	return n
+}
+
+// Files returns the paths of all files in the new revision of the diff in
+// sorted order.
+func (d *Diff) Files() []string {
+	var files []string
+	for path := range d.lines {
+		files = append(files, path)
+	}
+	sort.Strings(files)
+
+	return files
+}
+
+// Overlaps reports whether any of the lines from `start` to `end` of the
+// file at `path` were added or modified.
+func (d *Diff) Overlaps(path string, start, end int) bool {
+	lines := d.lines[path]
+	for line := start; line <= end; line++ {
+		if _, ok := lines[line]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package diff_test
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/s2gatev/lingo/diff"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDiff = `diff --git a/foo.go b/foo.go
+index 1111111..2222222 100644
+--- a/foo.go
++++ b/foo.go
+@@ -1,5 +1,6 @@
+ package foo
+ 
+-var a = 1
++var a = 2
++var b = 3
+ 
+ func foo() {}
+@@ -20,3 +21,3 @@ func bar() {
+ 	x := 1
+--- removed line which looks like a header
++++ added line which looks like a header
+ 	return x
+diff --git a/bar/baz.go b/bar/baz.go
+new file mode 100644
+--- /dev/null
++++ b/bar/baz.go
+@@ -0,0 +1 @@
++package bar
+diff --git a/qux.go b/qux.go
+deleted file mode 100644
+--- a/qux.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package qux
+`
+
+func TestParse(t *testing.T) {
+	d, err := Parse(strings.NewReader(testDiff), "/root")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"/root/bar/baz.go", "/root/foo.go"}, d.Files())
+
+	type test struct {
+		description string
+		path        string
+		start       int
+		end         int
+		expected    bool
+	}
+
+	tests := []test{
+		{
+			description: "context line",
+			path:        "/root/foo.go",
+			start:       1,
+			end:         1,
+			expected:    false,
+		},
+		{
+			description: "modified line",
+			path:        "/root/foo.go",
+			start:       3,
+			end:         3,
+			expected:    true,
+		},
+		{
+			description: "added line",
+			path:        "/root/foo.go",
+			start:       4,
+			end:         4,
+			expected:    true,
+		},
+		{
+			description: "range with added line",
+			path:        "/root/foo.go",
+			start:       5,
+			end:         22,
+			expected:    true,
+		},
+		{
+			description: "context line after removed line",
+			path:        "/root/foo.go",
+			start:       23,
+			end:         23,
+			expected:    false,
+		},
+		{
+			description: "new file",
+			path:        "/root/bar/baz.go",
+			start:       1,
+			end:         1,
+			expected:    true,
+		},
+		{
+			description: "file not in diff",
+			path:        "/root/qux.go",
+			start:       1,
+			end:         1,
+			expected:    false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			actual := d.Overlaps(test.path, test.start, test.end)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestParseInvalidHunkHeader(t *testing.T) {
+	_, err := Parse(strings.NewReader("+++ b/foo.go\n@@ invalid @@\n"), "/root")
+	assert.Error(t, err)
+}
@@ -27,8 +27,8 @@ func NewFeeder(matchers ...Matcher) *Feeder {
 	}
 }
 
-// Feed feeds all files starting from a `root` directory to a
-// chan of paths.
+// Feed feeds all regular files starting from a `root` directory
+// to a chan of paths.
 // If the error return value is not nil then the chan return
 // value is nil.
 func (f *Feeder) Feed(root string) (<-chan string, error) {
@@ -61,7 +61,7 @@ func (f *Feeder) feedDir(dir string, paths chan<- string) {
 	}
 
 	for _, file := range files {
-		if file.IsDir() {
+		if !file.Mode().IsRegular() {
 			continue
 		}
 
@@ -74,6 +74,10 @@ func (f *Feeder) feedDir(dir string, paths chan<- string) {
 
 func (f *Feeder) feedDirRecursive(dir string, paths chan<- string) {
 	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || !info.Mode().IsRegular() {
+			return nil
+		}
+
 		if f.matches(path) {
 			paths <- path
 		}
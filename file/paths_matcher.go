@@ -0,0 +1,53 @@
+package file
+
+import (
+	"path/filepath"
+
+	"github.com/uber-go/mapdecode"
+)
+
+func init() {
+	must(Register("paths", PathsMatcher))
+}
+
+// PathsMatcherConfig describes the configuration of a PathsMatcher.
+type PathsMatcherConfig struct {
+
+	// Paths are the paths of the accepted files. Relative paths are
+	// relative to the working directory.
+	Paths []string `yaml:"paths"`
+}
+
+type pathsMatcher struct {
+	paths map[string]struct{}
+}
+
+// PathsMatcher creates a new Matcher that accepts only the files in a
+// list of paths.
+func PathsMatcher(configData interface{}) Matcher {
+	var config PathsMatcherConfig
+	if err := mapdecode.Decode(&config, configData); err != nil {
+		return nil
+	}
+
+	m := &pathsMatcher{
+		paths: map[string]struct{}{},
+	}
+
+	for _, path := range config.Paths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+
+		m.paths[absPath] = struct{}{}
+	}
+
+	return m
+}
+
+// Matches implements the Matcher interface.
+func (m *pathsMatcher) Matches(path string) bool {
+	_, ok := m.paths[path]
+	return ok
+}
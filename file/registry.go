@@ -8,7 +8,7 @@ type MatcherConstructor func(configData interface{}) Matcher
 // Register adds a matcher to the registry.
 func Register(slug string, constructor MatcherConstructor) error {
 	if _, ok := registry[slug]; ok {
-		return fmt.Errorf("matcher already registered: " + slug)
+		return fmt.Errorf("matcher already registered: %s", slug)
 	}
 
 	registry[slug] = constructor
@@ -7,6 +7,9 @@ import (
 	"github.com/s2gatev/lingo/cmd"
 )
 
+//go:generate -command checkers go run main.go checkers --defaults
+//go:generate checkers --format markdown --output doc/checkers.md
+
 func main() {
 	if err := cmd.Root.Execute(); err != nil {
 		fmt.Println(err)
@@ -0,0 +1,105 @@
+package reporter
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+func init() {
+	must(Register(checkstyleSlug, NewCheckstyleReporter))
+}
+
+const checkstyleSlug = "checkstyle"
+
+// checkstyleVersion is the version of the Checkstyle format.
+const checkstyleVersion = "5.0"
+
+// CheckstyleReporter writes the result as a Checkstyle XML document.
+type CheckstyleReporter struct{}
+
+// NewCheckstyleReporter constructs a CheckstyleReporter.
+func NewCheckstyleReporter() Reporter {
+	return &CheckstyleReporter{}
+}
+
+type checkstyleDocument struct {
+
+	// XMLName is the name of the root element.
+	XMLName xml.Name `xml:"checkstyle"`
+
+	// Version is the version of the Checkstyle format.
+	Version string `xml:"version,attr"`
+
+	// Files contains all checked files.
+	Files []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+
+	// Name is the path of the file relative to the working directory.
+	Name string `xml:"name,attr"`
+
+	// Errors contains the violations in the file.
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+
+	// Line is the line where the violation starts.
+	Line int `xml:"line,attr"`
+
+	// Column is the column where the violation starts.
+	Column int `xml:"column,attr"`
+
+	// Severity is the name of the severity of the violation.
+	Severity string `xml:"severity,attr"`
+
+	// Message is the violation message.
+	Message string `xml:"message,attr"`
+
+	// Source is the slug of the checker that registered the violation.
+	Source string `xml:"source,attr"`
+}
+
+// Report implements the Reporter interface.
+func (r *CheckstyleReporter) Report(w io.Writer, result *Result) error {
+	document := checkstyleDocument{
+		Version: checkstyleVersion,
+	}
+
+	for _, file := range result.Files {
+		entry := checkstyleFile{
+			Name: relativePath(file.Path),
+		}
+
+		for _, violation := range file.Violations {
+			entry.Errors = append(entry.Errors, checkstyleError{
+				Line:     violation.Line,
+				Column:   violation.Column,
+				Severity: violation.Severity.String(),
+				Message:  violation.Message,
+				Source:   violation.Slug,
+			})
+		}
+
+		document.Files = append(document.Files, entry)
+	}
+
+	return writeXML(w, document)
+}
+
+// writeXML writes `document` to `w` as an indented XML document.
+func writeXML(w io.Writer, document interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,7 @@
+package reporter_test
+
+import "testing"
+
+func TestCheckstyleReporter(t *testing.T) {
+	AssertReporter(t, "checkstyle")
+}
@@ -0,0 +1,82 @@
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/s2gatev/lingo/checker"
+)
+
+func init() {
+	must(Register(githubSlug, NewGitHubReporter))
+}
+
+const githubSlug = "github"
+
+// GitHubReporter writes every violation as a GitHub Actions workflow
+// command which annotates the violating code.
+type GitHubReporter struct{}
+
+// NewGitHubReporter constructs a GitHubReporter.
+func NewGitHubReporter() Reporter {
+	return &GitHubReporter{}
+}
+
+// Report implements the Reporter interface.
+func (r *GitHubReporter) Report(w io.Writer, result *Result) error {
+	for _, file := range result.Files {
+		path := githubEscapeProperty(relativePath(file.Path))
+
+		for _, violation := range file.Violations {
+			_, err := fmt.Fprintf(w,
+				"::%s file=%s,line=%d,col=%d,endLine=%d,endColumn=%d,title=%s::%s\n",
+				githubCommand(violation.Severity), path,
+				violation.Line, violation.Column,
+				violation.EndLine, violation.EndColumn,
+				githubEscapeProperty(violation.Slug),
+				githubEscapeData(violation.Message))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// githubCommand returns the workflow command for `severity`.
+func githubCommand(severity checker.Severity) string {
+	switch severity {
+	case checker.SeverityWarning:
+		return "warning"
+	case checker.SeverityInfo:
+		return "notice"
+	default:
+		return "error"
+	}
+}
+
+var githubDataReplacer = strings.NewReplacer(
+	"%", "%25",
+	"\r", "%0D",
+	"\n", "%0A",
+)
+
+var githubPropertyReplacer = strings.NewReplacer(
+	"%", "%25",
+	"\r", "%0D",
+	"\n", "%0A",
+	":", "%3A",
+	",", "%2C",
+)
+
+// githubEscapeData escapes the message of a workflow command.
+func githubEscapeData(data string) string {
+	return githubDataReplacer.Replace(data)
+}
+
+// githubEscapeProperty escapes a property value of a workflow command.
+func githubEscapeProperty(property string) string {
+	return githubPropertyReplacer.Replace(property)
+}
@@ -0,0 +1,7 @@
+package reporter_test
+
+import "testing"
+
+func TestGitHubReporter(t *testing.T) {
+	AssertReporter(t, "github")
+}
@@ -0,0 +1,113 @@
+package reporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/s2gatev/lingo/checker"
+)
+
+func init() {
+	must(Register(gitlabSlug, NewGitLabReporter))
+}
+
+const gitlabSlug = "gitlab"
+
+// GitLabReporter writes the result as a GitLab Code Quality report.
+type GitLabReporter struct{}
+
+// NewGitLabReporter constructs a GitLabReporter.
+func NewGitLabReporter() Reporter {
+	return &GitLabReporter{}
+}
+
+type gitlabIssue struct {
+
+	// Description is the violation message.
+	Description string `json:"description"`
+
+	// CheckName is the slug of the checker that registered the violation.
+	CheckName string `json:"check_name"`
+
+	// Fingerprint identifies the violation across runs.
+	Fingerprint string `json:"fingerprint"`
+
+	// Severity is the Code Quality severity of the violation.
+	Severity string `json:"severity"`
+
+	// Location is the location of the violation.
+	Location gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+
+	// Path is the path of the file relative to the repository root.
+	Path string `json:"path"`
+
+	// Lines contains the line where the violation starts.
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+
+	// Begin is the line where the violation starts.
+	Begin int `json:"begin"`
+}
+
+// Report implements the Reporter interface.
+func (r *GitLabReporter) Report(w io.Writer, result *Result) error {
+	issues := []gitlabIssue{}
+	for _, file := range result.Files {
+		path := relativePath(file.Path)
+
+		// Fingerprints do not depend on line numbers so that they survive
+		// unrelated changes. Identical violations in a file are told apart
+		// by their order.
+		occurrences := map[string]int{}
+		for _, violation := range file.Violations {
+			key := violation.Slug + "\x00" + violation.Message
+			occurrences[key]++
+
+			issues = append(issues, gitlabIssue{
+				Description: violation.Message,
+				CheckName:   violation.Slug,
+				Fingerprint: gitlabFingerprint(path, key, occurrences[key]),
+				Severity:    gitlabSeverity(violation.Severity),
+				Location: gitlabLocation{
+					Path: path,
+					Lines: gitlabLines{
+						Begin: violation.Line,
+					},
+				},
+			})
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(issues)
+}
+
+// gitlabFingerprint returns the fingerprint of the `occurrence`-th violation
+// identified by `key` in the file at `path`.
+func gitlabFingerprint(path, key string, occurrence int) string {
+	hash := sha256.Sum256([]byte(
+		fmt.Sprintf("%s\x00%s\x00%d", path, key, occurrence)))
+	return hex.EncodeToString(hash[:])
+}
+
+// gitlabSeverity returns the Code Quality severity of `severity`.
+func gitlabSeverity(severity checker.Severity) string {
+	switch severity {
+	case checker.SeverityWarning:
+		return "minor"
+	case checker.SeverityInfo:
+		return "info"
+	default:
+		return "major"
+	}
+}
@@ -0,0 +1,49 @@
+package reporter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	. "github.com/s2gatev/lingo/reporter"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitLabReporter(t *testing.T) {
+	AssertReporter(t, "gitlab")
+}
+
+func TestGitLabReporterFingerprintIgnoresLines(t *testing.T) {
+	fingerprint := func(line int) string {
+		result := &Result{
+			Files: []File{
+				{
+					Path: "foo.go",
+					Violations: []Violation{
+						{
+							Slug:    "line_length",
+							Line:    line,
+							Column:  1,
+							Message: "line is too long",
+						},
+					},
+				},
+			},
+		}
+
+		var output bytes.Buffer
+		err := Get("gitlab").Report(&output, result)
+		assert.NoError(t, err)
+
+		var issues []struct {
+			Fingerprint string `json:"fingerprint"`
+		}
+		err = json.Unmarshal(output.Bytes(), &issues)
+		assert.NoError(t, err)
+
+		return issues[0].Fingerprint
+	}
+
+	assert.Equal(t, fingerprint(1), fingerprint(42))
+}
@@ -0,0 +1,116 @@
+package reporter
+
+import (
+	"encoding/json"
+	"io"
+)
+
+func init() {
+	must(Register(jsonSlug, NewJSONReporter))
+}
+
+const jsonSlug = "json"
+
+// JSONReporter writes the result as a JSON document.
+type JSONReporter struct{}
+
+// NewJSONReporter constructs a JSONReporter.
+func NewJSONReporter() Reporter {
+	return &JSONReporter{}
+}
+
+// jsonDocument is the JSON document written by JSONReporter.
+type jsonDocument struct {
+
+	// Files contains all checked files.
+	Files []jsonFile `json:"files"`
+
+	// Summary contains the counts of files and violations.
+	Summary jsonSummary `json:"summary"`
+}
+
+type jsonFile struct {
+
+	// Path is the path of the file.
+	Path string `json:"path"`
+
+	// Violations contains the violations in the file.
+	Violations []jsonViolation `json:"violations"`
+}
+
+type jsonViolation struct {
+
+	// Slug is the slug of the checker that registered the violation.
+	Slug string `json:"slug"`
+
+	// Severity is the name of the severity of the violation.
+	Severity string `json:"severity"`
+
+	// Line is the line where the violation starts.
+	Line int `json:"line"`
+
+	// Column is the column where the violation starts.
+	Column int `json:"column"`
+
+	// EndLine is the line where the violation ends.
+	EndLine int `json:"end_line"`
+
+	// EndColumn is the column where the violation ends.
+	EndColumn int `json:"end_column"`
+
+	// Message is the violation message.
+	Message string `json:"message"`
+}
+
+type jsonSummary struct {
+
+	// Files is the number of checked files.
+	Files int `json:"files"`
+
+	// FilesWithViolations is the number of files with violations.
+	FilesWithViolations int `json:"files_with_violations"`
+
+	// Violations is the number of violations in all files.
+	Violations int `json:"violations"`
+}
+
+// Report implements the Reporter interface.
+func (r *JSONReporter) Report(w io.Writer, result *Result) error {
+	document := jsonDocument{
+		Files: []jsonFile{},
+	}
+
+	for _, file := range result.Files {
+		// Violations are always a list so that consumers need not handle
+		// null.
+		entry := jsonFile{
+			Path:       relativePath(file.Path),
+			Violations: []jsonViolation{},
+		}
+
+		for _, violation := range file.Violations {
+			entry.Violations = append(entry.Violations, jsonViolation{
+				Slug:      violation.Slug,
+				Severity:  violation.Severity.String(),
+				Line:      violation.Line,
+				Column:    violation.Column,
+				EndLine:   violation.EndLine,
+				EndColumn: violation.EndColumn,
+				Message:   violation.Message,
+			})
+		}
+
+		document.Files = append(document.Files, entry)
+
+		document.Summary.Files++
+		document.Summary.Violations += len(file.Violations)
+		if len(file.Violations) > 0 {
+			document.Summary.FilesWithViolations++
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(document)
+}
@@ -0,0 +1,7 @@
+package reporter_test
+
+import "testing"
+
+func TestJSONReporter(t *testing.T) {
+	AssertReporter(t, "json")
+}
@@ -0,0 +1,171 @@
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+)
+
+func init() {
+	must(Register(junitSlug, NewJUnitReporter))
+}
+
+const junitSlug = "junit"
+
+// JUnitReporter writes the result as a JUnit XML document. Every checker is
+// a test suite in which every file checked by the checker is a test case.
+type JUnitReporter struct{}
+
+// NewJUnitReporter constructs a JUnitReporter.
+func NewJUnitReporter() Reporter {
+	return &JUnitReporter{}
+}
+
+type junitDocument struct {
+
+	// XMLName is the name of the root element.
+	XMLName xml.Name `xml:"testsuites"`
+
+	// Name is the name of the tool.
+	Name string `xml:"name,attr"`
+
+	// Tests is the number of test cases in all suites.
+	Tests int `xml:"tests,attr"`
+
+	// Failures is the number of failed test cases in all suites.
+	Failures int `xml:"failures,attr"`
+
+	// Suites contains a suite for every checker.
+	Suites []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+
+	// Name is the slug of the checker.
+	Name string `xml:"name,attr"`
+
+	// Tests is the number of test cases in the suite.
+	Tests int `xml:"tests,attr"`
+
+	// Failures is the number of failed test cases in the suite.
+	Failures int `xml:"failures,attr"`
+
+	// Cases contains a test case for every file checked by the checker.
+	Cases []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+
+	// Name is the path of the file.
+	Name string `xml:"name,attr"`
+
+	// ClassName is the slug of the checker.
+	ClassName string `xml:"classname,attr"`
+
+	// Failures contains the violations of the checker in the file.
+	Failures []junitFailure `xml:"failure"`
+}
+
+type junitFailure struct {
+
+	// Message is the position and the message of the violation.
+	Message string `xml:"message,attr"`
+
+	// Type is the name of the severity of the violation.
+	Type string `xml:"type,attr"`
+
+	// Text is the position and the message of the violation.
+	Text string `xml:",chardata"`
+}
+
+// Report implements the Reporter interface.
+func (r *JUnitReporter) Report(w io.Writer, result *Result) error {
+	document := junitDocument{
+		Name: "lingo",
+	}
+
+	for _, slug := range r.slugs(result) {
+		suite := junitSuite{
+			Name: slug,
+		}
+
+		for _, file := range result.Files {
+			testCase := r.testCase(file, slug)
+			if len(testCase.Failures) == 0 && !checkedBy(file, slug) {
+				continue
+			}
+
+			suite.Cases = append(suite.Cases, testCase)
+			suite.Tests++
+			if len(testCase.Failures) > 0 {
+				suite.Failures++
+			}
+		}
+
+		document.Suites = append(document.Suites, suite)
+		document.Tests += suite.Tests
+		document.Failures += suite.Failures
+	}
+
+	return writeXML(w, document)
+}
+
+// testCase returns the test case of the checker with `slug` in `file`.
+func (r *JUnitReporter) testCase(file File, slug string) junitCase {
+	path := relativePath(file.Path)
+	testCase := junitCase{
+		Name:      path,
+		ClassName: slug,
+	}
+
+	for _, violation := range file.Violations {
+		if violation.Slug != slug {
+			continue
+		}
+
+		testCase.Failures = append(testCase.Failures, junitFailure{
+			Message: fmt.Sprintf("%d:%d: %s",
+				violation.Line, violation.Column, violation.Message),
+			Type: violation.Severity.String(),
+			Text: fmt.Sprintf("%s:%d:%d: %s",
+				path, violation.Line, violation.Column, violation.Message),
+		})
+	}
+
+	return testCase
+}
+
+// checkedBy reports whether `file` was checked by the checker with `slug`.
+func checkedBy(file File, slug string) bool {
+	for _, checked := range file.Checkers {
+		if checked == slug {
+			return true
+		}
+	}
+
+	return false
+}
+
+// slugs returns the slugs of all checkers of `result` and of all checkers
+// which registered a violation in sorted order.
+func (r *JUnitReporter) slugs(result *Result) []string {
+	slugSet := map[string]struct{}{}
+	for slug := range result.Checkers {
+		slugSet[slug] = struct{}{}
+	}
+
+	for _, file := range result.Files {
+		for _, violation := range file.Violations {
+			slugSet[violation.Slug] = struct{}{}
+		}
+	}
+
+	var slugs []string
+	for slug := range slugSet {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	return slugs
+}
@@ -0,0 +1,7 @@
+package reporter_test
+
+import "testing"
+
+func TestJUnitReporter(t *testing.T) {
+	AssertReporter(t, "junit")
+}
@@ -0,0 +1,160 @@
+package reporter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/s2gatev/lingo/checker"
+)
+
+// Result is the result of a check run.
+type Result struct {
+
+	// Files contains all checked files sorted by path.
+	Files []File
+
+	// Checkers contains the checkers of the run mapped by their slugs.
+	Checkers map[string]checker.NodeChecker
+}
+
+// File is the result of checking a single file.
+type File struct {
+
+	// Path is the path of the file.
+	Path string
+
+	// Violations contains the violations in the file sorted by position.
+	Violations []Violation
+
+	// Suppressed contains the violations in the file which were suppressed
+	// by comments.
+	Suppressed []Violation
+
+	// Checkers contains the slugs of the checkers which checked the file in
+	// sorted order.
+	Checkers []string
+}
+
+// Violation is a checker violation with a resolved position.
+type Violation struct {
+
+	// Slug is the slug of the checker that registered the violation.
+	Slug string
+
+	// Severity is the severity of the violation.
+	Severity checker.Severity
+
+	// Line is the line where the violation starts, starting at 1.
+	Line int
+
+	// Column is the column where the violation starts, starting at 1.
+	Column int
+
+	// EndLine is the line where the violation ends, starting at 1.
+	EndLine int
+
+	// EndColumn is the column where the violation ends, starting at 1.
+	EndColumn int
+
+	// Message is the violation message.
+	Message string
+}
+
+// ViolationCount returns the number of violations in all files.
+func (r *Result) ViolationCount() int {
+	count := 0
+	for _, file := range r.Files {
+		count += len(file.Violations)
+	}
+
+	return count
+}
+
+// ViolationCountAtLeast returns the number of violations in all files which
+// are at least as serious as `severity`.
+func (r *Result) ViolationCountAtLeast(severity checker.Severity) int {
+	count := 0
+	for _, file := range r.Files {
+		for _, violation := range file.Violations {
+			if violation.Severity.AtLeast(severity) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// relativePath returns `path` relative to the working directory if it is
+// absolute and inside the working directory.
+func relativePath(path string) string {
+	if !filepath.IsAbs(path) {
+		return path
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+
+	rel, err := filepath.Rel(wd, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return path
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// Reporter writes the result of a check run in some format.
+type Reporter interface {
+
+	// Report writes `result` to `w`.
+	Report(w io.Writer, result *Result) error
+}
+
+// ReporterConstructor constructs Reporter instances.
+type ReporterConstructor func() Reporter
+
+// Register adds a reporter to the registry.
+func Register(slug string, constructor ReporterConstructor) error {
+	if _, ok := registry[slug]; ok {
+		return fmt.Errorf("reporter already registered: %s", slug)
+	}
+
+	registry[slug] = constructor
+
+	return nil
+}
+
+// Get returns the Reporter referenced by a `slug`.
+func Get(slug string) Reporter {
+	constructor, ok := registry[slug]
+	if !ok {
+		return nil
+	}
+
+	return constructor()
+}
+
+// Slugs returns the slugs of all registered reporters in sorted order.
+func Slugs() []string {
+	var slugs []string
+	for slug := range registry {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	return slugs
+}
+
+var registry = map[string]ReporterConstructor{}
+
+func must(err error) {
+	if err != nil {
+		panic(err.Error())
+	}
+}
@@ -0,0 +1,228 @@
+package reporter_test
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/s2gatev/lingo/checker"
+	. "github.com/s2gatev/lingo/reporter"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// testResult is the result reported by all reporter tests.
+var testResult = &Result{
+	Files: []File{
+		{
+			Path: "foo/bar.go",
+			Violations: []Violation{
+				{
+					Slug:      "multi_word_ident_name",
+					Severity:  checker.SeverityError,
+					Line:      3,
+					Column:    5,
+					EndLine:   3,
+					EndColumn: 12,
+					Message:   "name 'foo_bar' is not valid",
+				},
+				{
+					Slug:      "line_length",
+					Severity:  checker.SeverityWarning,
+					Line:      10,
+					Column:    81,
+					EndLine:   10,
+					EndColumn: 95,
+					Message:   `line is too long: "<a & b>"`,
+				},
+			},
+			Checkers: []string{"line_length", "multi_word_ident_name"},
+		},
+		{
+			Path: "foo/baz.go",
+			Suppressed: []Violation{
+				{
+					Slug:      "multi_word_ident_name",
+					Severity:  checker.SeverityError,
+					Line:      5,
+					Column:    5,
+					EndLine:   5,
+					EndColumn: 12,
+					Message:   "name 'foo_baz' is not valid",
+				},
+			},
+			Checkers: []string{"multi_word_ident_name"},
+		},
+		{
+			Path: "qux.go",
+			Violations: []Violation{
+				{
+					Slug:      "exported_ident_doc",
+					Severity:  checker.SeverityInfo,
+					Line:      7,
+					Column:    1,
+					EndLine:   7,
+					EndColumn: 9,
+					Message:   "exported identifier 'Qux' is not documented",
+				},
+			},
+		},
+	},
+	Checkers: map[string]checker.NodeChecker{
+		"multi_word_ident_name": mustNew(checker.NewMultiWordIdentNameChecker(nil)),
+		"line_length": mustNew(checker.NewLineLengthChecker(map[string]interface{}{
+			"max_length": 80,
+		})),
+	},
+}
+
+// mustNew returns the NodeChecker `c` constructed by a NodeCheckerConstructor
+// and panics if its construction failed.
+func mustNew(c checker.NodeChecker, err error) checker.NodeChecker {
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return c
+}
+
+// testCases contains the results reported by every reporter mapped by the
+// names of their golden files. `wd` is the working directory.
+func testCases(wd string) map[string]*Result {
+	return map[string]*Result{
+		"empty": {},
+		"files": testResult,
+		"severities": {
+			Files: []File{
+				{
+					Path: "foo.go",
+					Violations: []Violation{
+						testViolation("multi_word_ident_name", checker.SeverityError, 1),
+						testViolation("line_length", checker.SeverityWarning, 2),
+						testViolation("exported_ident_doc", checker.SeverityInfo, 3),
+						testViolation("suppression", checker.SeverityError, 4),
+					},
+				},
+			},
+			Checkers: testResult.Checkers,
+		},
+		"escaping": {
+			Files: []File{
+				{
+					Path: `foo/<a & 'b'>,c:d%.go`,
+					Violations: []Violation{
+						{
+							Slug:      "line_length",
+							Severity:  checker.SeverityWarning,
+							Line:      1,
+							Column:    1,
+							EndLine:   1,
+							EndColumn: 2,
+							Message:   "\"<a & 'b'>\" is 100% too long\nreally\tnow",
+						},
+					},
+				},
+			},
+			Checkers: testResult.Checkers,
+		},
+		"paths": {
+			Files: []File{
+				{
+					Path: filepath.Join(wd, "foo", "bar.go"),
+					Violations: []Violation{
+						testViolation("line_length", checker.SeverityError, 1),
+					},
+				},
+				{
+					Path: "/outside/lingo/baz.go",
+					Violations: []Violation{
+						testViolation("line_length", checker.SeverityError, 2),
+					},
+				},
+			},
+			Checkers: testResult.Checkers,
+		},
+		"duplicates": {
+			Files: []File{
+				{
+					Path: "foo.go",
+					Violations: []Violation{
+						testViolation("line_length", checker.SeverityError, 1),
+						testViolation("line_length", checker.SeverityError, 1),
+					},
+				},
+			},
+			Checkers: testResult.Checkers,
+		},
+	}
+}
+
+// testViolation returns a violation of the checker referenced by `slug` on
+// `line`.
+func testViolation(slug string, severity checker.Severity, line int) Violation {
+	return Violation{
+		Slug:      slug,
+		Severity:  severity,
+		Line:      line,
+		Column:    1,
+		EndLine:   line,
+		EndColumn: 10,
+		Message:   "violation of " + slug,
+	}
+}
+
+// AssertReporter reports every test case with the reporter referenced by
+// `slug` and compares the outputs with the golden files
+// testdata/`slug`_`case`.golden.
+func AssertReporter(t *testing.T, slug string) {
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+
+	for name, result := range testCases(wd) {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Get(slug).Report(&buf, result)
+			assert.NoError(t, err)
+
+			// Reporters which keep absolute paths would make the golden
+			// files depend on the working directory.
+			output := strings.Replace(buf.String(), wd, "$WD", -1)
+
+			golden := filepath.Join("testdata", slug+"_"+name+".golden")
+			if *update {
+				err := ioutil.WriteFile(golden, []byte(output), 0644)
+				assert.NoError(t, err)
+			}
+
+			expected, err := ioutil.ReadFile(golden)
+			assert.NoError(t, err)
+			assert.Equal(t, string(expected), output)
+		})
+	}
+}
+
+func TestResultViolationCount(t *testing.T) {
+	assert.Equal(t, 3, testResult.ViolationCount())
+}
+
+func TestResultViolationCountAtLeast(t *testing.T) {
+	assert.Equal(t, 1, testResult.ViolationCountAtLeast(checker.SeverityError))
+	assert.Equal(t, 2, testResult.ViolationCountAtLeast(checker.SeverityWarning))
+	assert.Equal(t, 3, testResult.ViolationCountAtLeast(checker.SeverityInfo))
+}
+
+func TestSlugs(t *testing.T) {
+	assert.Equal(t, []string{
+		"checkstyle", "github", "gitlab", "json", "junit", "sarif", "text",
+	}, Slugs())
+}
+
+func TestGetUnknownReporter(t *testing.T) {
+	assert.Nil(t, Get("unknown"))
+}
@@ -0,0 +1,330 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/s2gatev/lingo/checker"
+)
+
+func init() {
+	must(Register(sarifSlug, NewSARIFReporter))
+}
+
+const sarifSlug = "sarif"
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+
+	// sarifSourceRoot is the URI base of the files relative to the root of
+	// the analysed project.
+	sarifSourceRoot = "%SRCROOT%"
+)
+
+// SARIFReporter writes the result as a SARIF 2.1.0 log.
+type SARIFReporter struct{}
+
+// NewSARIFReporter constructs a SARIFReporter.
+func NewSARIFReporter() Reporter {
+	return &SARIFReporter{}
+}
+
+type sarifLog struct {
+
+	// Schema is the URI of the SARIF JSON schema.
+	Schema string `json:"$schema"`
+
+	// Version is the SARIF version of the log.
+	Version string `json:"version"`
+
+	// Runs contains the runs of the log.
+	Runs []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+
+	// Tool describes lingo and its checkers.
+	Tool sarifTool `json:"tool"`
+
+	// Results contains all violations of the run.
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+
+	// Driver describes lingo and its checkers.
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+
+	// Name is the name of the tool.
+	Name string `json:"name"`
+
+	// InformationURI is the home page of the tool.
+	InformationURI string `json:"informationUri"`
+
+	// Rules contains a descriptor of every checker.
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+
+	// ID is the slug of the checker.
+	ID string `json:"id"`
+
+	// Name is the title of the checker without spaces.
+	Name string `json:"name"`
+
+	// ShortDescription is the title of the checker.
+	ShortDescription sarifMessage `json:"shortDescription"`
+
+	// FullDescription is the description of the checker.
+	FullDescription sarifMessage `json:"fullDescription"`
+
+	// Help is the description of the checker with its examples.
+	Help sarifMessage `json:"help"`
+}
+
+type sarifMessage struct {
+
+	// Text is the plain text of the message.
+	Text string `json:"text"`
+
+	// Markdown is the message formatted as Markdown.
+	Markdown string `json:"markdown,omitempty"`
+}
+
+type sarifResult struct {
+
+	// RuleID is the slug of the checker that registered the violation.
+	RuleID string `json:"ruleId"`
+
+	// RuleIndex is the index of the descriptor of the checker or nil if the
+	// checker has no descriptor.
+	RuleIndex *int `json:"ruleIndex,omitempty"`
+
+	// Level is the SARIF level of the severity of the violation.
+	Level string `json:"level"`
+
+	// Message is the violation message.
+	Message sarifMessage `json:"message"`
+
+	// Locations contains the location of the violation.
+	Locations []sarifLocation `json:"locations"`
+
+	// Suppressions is set if the violation was suppressed.
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifLocation struct {
+
+	// PhysicalLocation is the location of the violation in a file.
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+
+	// ArtifactLocation identifies the file of the violation.
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+
+	// Region is the region of the violation in the file.
+	Region sarifRegion `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+
+	// URI is the URI of the file.
+	URI string `json:"uri"`
+
+	// URIBaseID is the base of the URI of the file if it is relative.
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+
+	// StartLine is the line where the violation starts.
+	StartLine int `json:"startLine"`
+
+	// StartColumn is the column where the violation starts.
+	StartColumn int `json:"startColumn"`
+
+	// EndLine is the line where the violation ends.
+	EndLine int `json:"endLine"`
+
+	// EndColumn is the column where the violation ends.
+	EndColumn int `json:"endColumn"`
+}
+
+type sarifSuppression struct {
+
+	// Kind is the kind of the suppression.
+	Kind string `json:"kind"`
+}
+
+// Report implements the Reporter interface.
+func (r *SARIFReporter) Report(w io.Writer, result *Result) error {
+	var slugs []string
+	for slug := range result.Checkers {
+		slugs = append(slugs, slug)
+	}
+	sort.Strings(slugs)
+
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "lingo",
+				InformationURI: "https://github.com/s2gatev/lingo",
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+
+	ruleIndices := map[string]int{}
+	for _, slug := range slugs {
+		ruleIndices[slug] = len(run.Tool.Driver.Rules)
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules,
+			r.rule(slug, result.Checkers[slug]))
+	}
+
+	for _, file := range result.Files {
+		location := r.artifactLocation(file.Path)
+
+		for _, violation := range file.Violations {
+			run.Results = append(run.Results,
+				r.result(location, violation, ruleIndices))
+		}
+
+		for _, violation := range file.Suppressed {
+			suppressed := r.result(location, violation, ruleIndices)
+			suppressed.Suppressions = []sarifSuppression{
+				{
+					Kind: "inSource",
+				},
+			}
+			run.Results = append(run.Results, suppressed)
+		}
+	}
+
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs:    []sarifRun{run},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(log)
+}
+
+func (r *SARIFReporter) rule(slug string, c checker.NodeChecker) sarifRule {
+	text := c.Description()
+	markdown := c.Description()
+	for _, example := range c.Examples() {
+		good := checker.Dedent(example.Good)
+		bad := checker.Dedent(example.Bad)
+
+		text += fmt.Sprintf("\n\nGood:\n%s\n\nBad:\n%s", good, bad)
+		markdown += fmt.Sprintf(
+			"\n\nGood:\n\n```go\n%s\n```\n\nBad:\n\n```go\n%s\n```", good, bad)
+	}
+
+	return sarifRule{
+		ID:   slug,
+		Name: ruleName(c.Title()),
+		ShortDescription: sarifMessage{
+			Text: c.Title(),
+		},
+		FullDescription: sarifMessage{
+			Text: c.Description(),
+		},
+		Help: sarifMessage{
+			Text:     text,
+			Markdown: markdown,
+		},
+	}
+}
+
+func (r *SARIFReporter) result(
+	location sarifArtifactLocation,
+	violation Violation,
+	ruleIndices map[string]int) sarifResult {
+
+	// Violations of malformed suppression comments are not registered by a
+	// checker and have no descriptor.
+	var ruleIndex *int
+	if index, ok := ruleIndices[violation.Slug]; ok {
+		ruleIndex = &index
+	}
+
+	return sarifResult{
+		RuleID:    violation.Slug,
+		RuleIndex: ruleIndex,
+		Level:     sarifLevel(violation.Severity),
+		Message: sarifMessage{
+			Text: violation.Message,
+		},
+		Locations: []sarifLocation{
+			{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: location,
+					Region: sarifRegion{
+						StartLine:   violation.Line,
+						StartColumn: violation.Column,
+						EndLine:     violation.EndLine,
+						EndColumn:   violation.EndColumn,
+					},
+				},
+			},
+		},
+	}
+}
+
+// artifactLocation returns the location of the file at `path`. Paths in the
+// working directory are relative to the root of the analysed project.
+func (r *SARIFReporter) artifactLocation(path string) sarifArtifactLocation {
+	path = relativePath(path)
+	uri := filepath.ToSlash(path)
+	if !filepath.IsAbs(path) {
+		return sarifArtifactLocation{
+			URI:       uri,
+			URIBaseID: sarifSourceRoot,
+		}
+	}
+
+	// Absolute paths on Windows start with a volume name.
+	if !strings.HasPrefix(uri, "/") {
+		uri = "/" + uri
+	}
+
+	return sarifArtifactLocation{
+		URI: "file://" + uri,
+	}
+}
+
+// sarifLevel returns the SARIF level of `severity`.
+func sarifLevel(severity checker.Severity) string {
+	switch severity {
+	case checker.SeverityWarning:
+		return "warning"
+	case checker.SeverityInfo:
+		return "note"
+	default:
+		return "error"
+	}
+}
+
+// ruleName returns `title` in PascalCase, e.g. MultiWordIdentifiers.
+func ruleName(title string) string {
+	return strings.Join(strings.FieldsFunc(title, func(char rune) bool {
+		return !unicode.IsLetter(char) && !unicode.IsDigit(char)
+	}), "")
+}
@@ -0,0 +1,7 @@
+package reporter_test
+
+import "testing"
+
+func TestSARIFReporter(t *testing.T) {
+	AssertReporter(t, "sarif")
+}
@@ -0,0 +1,36 @@
+package reporter
+
+import (
+	"fmt"
+	"io"
+)
+
+func init() {
+	must(Register(textSlug, NewTextReporter))
+}
+
+const textSlug = "text"
+
+// TextReporter writes every violation on a line of its own.
+type TextReporter struct{}
+
+// NewTextReporter constructs a TextReporter.
+func NewTextReporter() Reporter {
+	return &TextReporter{}
+}
+
+// Report implements the Reporter interface.
+func (r *TextReporter) Report(w io.Writer, result *Result) error {
+	for _, file := range result.Files {
+		for _, violation := range file.Violations {
+			_, err := fmt.Fprintf(w, "%s:%d:%d: %s: %s (%s)\n",
+				relativePath(file.Path), violation.Line, violation.Column,
+				violation.Severity, violation.Message, violation.Slug)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,7 @@
+package reporter_test
+
+import "testing"
+
+func TestTextReporter(t *testing.T) {
+	AssertReporter(t, "text")
+}